@@ -0,0 +1,278 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/base58"
+	"github.com/mutalisk999/bitcoin-lib/src/utility"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	AddressesQueryMax       = 1000
+	AddressesQueryWorkerMax = 8
+)
+
+type AddressTrxIdsPrintAble struct {
+	Address string
+	TrxIds  []string
+}
+
+type AddressesTrxsPrintAble struct {
+	Addresses []AddressTrxIdsPrintAble
+	Trxs      []TrxStatusPrintAble
+}
+
+type AddressUtxosPrintAble struct {
+	Address string
+	Utxos   []UtxoPrintAble
+}
+
+type AddressBalancePrintAble struct {
+	Address   string
+	Balance   int64
+	UtxoCount uint32
+	TrxCount  uint32
+}
+
+type AddressesBalancePrintAble struct {
+	Balance   int64
+	UtxoCount uint32
+	Addresses []AddressBalancePrintAble
+}
+
+// getScriptFromAddress decodes the address of the network to the script pubkey
+func getScriptFromAddress(addrStr string) ([]byte, error) {
+	if strings.HasPrefix(strings.ToLower(addrStr), networkParams.SegWitHrp+"1") {
+		version, program, err := decodeSegWitAddress(networkParams.SegWitHrp, addrStr)
+		if err != nil {
+			return nil, err
+		}
+		return getSegWitScript(version, program), nil
+	}
+	addrBytes, err := base58.Decode(addrStr)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrBytes) != 25 {
+		return nil, errors.New("invalid address size")
+	}
+	checkSum := utility.Sha256(utility.Sha256(addrBytes[0:21]))[0:4]
+	if !bytes.Equal(checkSum, addrBytes[21:25]) {
+		return nil, errors.New("invalid address checksum")
+	}
+	if addrBytes[0] == networkParams.PubKeyHashVersion {
+		scriptBytes := append([]byte{0x76, 0xa9, 0x14}, addrBytes[1:21]...)
+		return append(scriptBytes, 0x88, 0xac), nil
+	} else if addrBytes[0] == networkParams.ScriptHashVersion {
+		scriptBytes := append([]byte{0xa9, 0x14}, addrBytes[1:21]...)
+		return append(scriptBytes, 0x87), nil
+	}
+	return nil, errors.New("not support address version")
+}
+
+func uniqueAddresses(addresses []string) ([]string, error) {
+	addressesUnique := make([]string, 0, len(addresses))
+	addressesMap := make(map[string]bool)
+	for _, addrStr := range addresses {
+		if addrStr == "" || addressesMap[addrStr] {
+			continue
+		}
+		addressesMap[addrStr] = true
+		addressesUnique = append(addressesUnique, addrStr)
+	}
+	if len(addressesUnique) == 0 {
+		return nil, errors.New("address list is empty")
+	}
+	if len(addressesUnique) > AddressesQueryMax {
+		return nil, errors.New("too many addresses, max: " + strconv.Itoa(AddressesQueryMax))
+	}
+	return addressesUnique, nil
+}
+
+// queryConcurrently runs fn for every index in [0, count) with a bounded number of workers
+func queryConcurrently(count int, fn func(index int) error) error {
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var errFirst error
+	indexChan := make(chan int)
+	workerCount := AddressesQueryWorkerMax
+	if count < workerCount {
+		workerCount = count
+	}
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexChan {
+				err := fn(index)
+				if err != nil {
+					errOnce.Do(func() { errFirst = err })
+				}
+			}
+		}()
+	}
+	for index := 0; index < count; index++ {
+		indexChan <- index
+	}
+	close(indexChan)
+	wg.Wait()
+	return errFirst
+}
+
+// getAddressTrxSeqs returns the sorted trx seqs of the address, a trx is indexed once for every
+// input and output of the address, the duplicates are removed
+func getAddressTrxSeqs(addrStr string) ([]uint32, error) {
+	trxSeqs, err := addrTrxsDBMgr.DBGetPrefix(addrStr + ".")
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(trxSeqs, func(i, j int) bool { return trxSeqs[i] < trxSeqs[j] })
+	trxSeqsUnique := make([]uint32, 0, len(trxSeqs))
+	for i, trxSeq := range trxSeqs {
+		if i > 0 && trxSeq == trxSeqs[i-1] {
+			continue
+		}
+		trxSeqsUnique = append(trxSeqsUnique, trxSeq)
+	}
+	return trxSeqsUnique, nil
+}
+
+func getAddressUtxos(addrStr string, trxSeqs []uint32) ([]UtxoPrintAble, error) {
+	utxos := []UtxoPrintAble{}
+	for _, trxSeq := range trxSeqs {
+		trxId, err := trxSeqDBMgr.DBGet(trxSeq)
+		if err != nil {
+			continue
+		}
+		err = utxoDBMgr.DBIterateTrxId(trxId, func(utxoSrc UtxoSource, utxoDetail UtxoDetail) error {
+			if utxoDetail.Address == addrStr {
+				utxos = append(utxos, GetUtxoPrintAble(&utxoSrc, &utxoDetail))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return utxos, nil
+}
+
+func getAddressesTrxs(addresses []string) (AddressesTrxsPrintAble, error) {
+	var addressesTrxs AddressesTrxsPrintAble
+	addressesTrxs.Addresses = make([]AddressTrxIdsPrintAble, len(addresses))
+	addressesTrxSeqs := make([][]uint32, len(addresses))
+	err := queryConcurrently(len(addresses), func(index int) error {
+		addrStr := addresses[index]
+		trxSeqs, err := getAddressTrxSeqs(addrStr)
+		if err != nil {
+			return err
+		}
+		addressesTrxSeqs[index] = trxSeqs
+		return nil
+	})
+	if err != nil {
+		return AddressesTrxsPrintAble{}, err
+	}
+
+	// the trxs shared by the addresses are resolved only once
+	trxIdsMap := make(map[uint32]string)
+	var trxSeqsUnique []uint32
+	for _, trxSeqs := range addressesTrxSeqs {
+		for _, trxSeq := range trxSeqs {
+			_, ok := trxIdsMap[trxSeq]
+			if !ok {
+				trxIdsMap[trxSeq] = ""
+				trxSeqsUnique = append(trxSeqsUnique, trxSeq)
+			}
+		}
+	}
+	sort.Slice(trxSeqsUnique, func(i, j int) bool { return trxSeqsUnique[i] < trxSeqsUnique[j] })
+	addressesTrxs.Trxs = make([]TrxStatusPrintAble, len(trxSeqsUnique))
+	err = queryConcurrently(len(trxSeqsUnique), func(index int) error {
+		trxSeq := trxSeqsUnique[index]
+		trxId, err := trxSeqDBMgr.DBGet(trxSeq)
+		if err != nil {
+			return newNotFoundError("trx sequence")
+		}
+		trxStatus, err := getTrxStatusPrintAble(trxId)
+		if err != nil {
+			// the trx is indexed without location
+			trxStatus.TrxId = trxId.GetHex()
+			trxStatus.TrxSeq = trxSeq
+		}
+		addressesTrxs.Trxs[index] = trxStatus
+		return nil
+	})
+	if err != nil {
+		return AddressesTrxsPrintAble{}, err
+	}
+	for _, trxStatus := range addressesTrxs.Trxs {
+		trxIdsMap[trxStatus.TrxSeq] = trxStatus.TrxId
+	}
+
+	for index, addrStr := range addresses {
+		addressesTrxs.Addresses[index].Address = addrStr
+		addressesTrxs.Addresses[index].TrxIds = []string{}
+		for _, trxSeq := range addressesTrxSeqs[index] {
+			addressesTrxs.Addresses[index].TrxIds = append(addressesTrxs.Addresses[index].TrxIds, trxIdsMap[trxSeq])
+		}
+	}
+	return addressesTrxs, nil
+}
+
+func listUnSpentMulti(addresses []string) ([]AddressUtxosPrintAble, error) {
+	addressesUtxos := make([]AddressUtxosPrintAble, len(addresses))
+	err := queryConcurrently(len(addresses), func(index int) error {
+		addrStr := addresses[index]
+		trxSeqs, err := getAddressTrxSeqs(addrStr)
+		if err != nil {
+			return err
+		}
+		utxos, err := getAddressUtxos(addrStr, trxSeqs)
+		if err != nil {
+			return err
+		}
+		addressesUtxos[index] = AddressUtxosPrintAble{addrStr, utxos}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return addressesUtxos, nil
+}
+
+func getAddressesBalance(addresses []string) (AddressesBalancePrintAble, error) {
+	var addressesBalance AddressesBalancePrintAble
+	addressesBalance.Addresses = make([]AddressBalancePrintAble, len(addresses))
+	err := queryConcurrently(len(addresses), func(index int) error {
+		addrStr := addresses[index]
+		trxSeqs, err := getAddressTrxSeqs(addrStr)
+		if err != nil {
+			return err
+		}
+		utxos, err := getAddressUtxos(addrStr, trxSeqs)
+		if err != nil {
+			return err
+		}
+		addressBalance := &addressesBalance.Addresses[index]
+		addressBalance.Address = addrStr
+		addressBalance.UtxoCount = uint32(len(utxos))
+		addressBalance.TrxCount = uint32(len(trxSeqs))
+		for _, utxo := range utxos {
+			addressBalance.Balance += utxo.Amount
+		}
+		return nil
+	})
+	if err != nil {
+		return AddressesBalancePrintAble{}, err
+	}
+	for _, addressBalance := range addressesBalance.Addresses {
+		addressesBalance.Balance += addressBalance.Balance
+		addressesBalance.UtxoCount += addressBalance.UtxoCount
+	}
+	return addressesBalance, nil
+}
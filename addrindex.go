@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+// AddressIndexArgs is the argument of the address index rpcs of bitcoind with the addrindex patch,
+// {"addresses": [...], "start": h, "end": h} or a single address string
+type AddressIndexArgs struct {
+	Addresses []string `json:"addresses"`
+	Start     uint32   `json:"start"`
+	End       uint32   `json:"end"`
+}
+
+func (a *AddressIndexArgs) UnmarshalJSON(data []byte) error {
+	var addrStr string
+	err := json.Unmarshal(data, &addrStr)
+	if err == nil {
+		a.Addresses = []string{addrStr}
+		return nil
+	}
+	type addressIndexArgs AddressIndexArgs
+	var args addressIndexArgs
+	err = json.Unmarshal(data, &args)
+	if err != nil {
+		return err
+	}
+	*a = AddressIndexArgs(args)
+	return nil
+}
+
+type AddressIndexUtxoPrintAble struct {
+	Address     string `json:"address"`
+	TrxId       string `json:"txid"`
+	OutputIndex uint32 `json:"outputIndex"`
+	Script      string `json:"script"`
+	Satoshis    int64  `json:"satoshis"`
+	Height      uint32 `json:"height"`
+}
+
+type AddressIndexBalancePrintAble struct {
+	Balance  int64 `json:"balance"`
+	Received int64 `json:"received"`
+}
+
+// getAddressIndexTrxIds returns the trx ids of the addresses ordered by the chain, within [start, end] if end is set
+func getAddressIndexTrxIds(args *AddressIndexArgs) ([]string, error) {
+	addresses, err := uniqueAddresses(args.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	if args.End != 0 && args.End < args.Start {
+		return nil, errors.New("invalid start and end range")
+	}
+	trxsMap := make(map[uint32]TrxStatusPrintAble)
+	for _, addrStr := range addresses {
+		trxSeqs, err := getAddressTrxSeqs(addrStr)
+		if err != nil {
+			continue
+		}
+		for _, trxSeq := range trxSeqs {
+			if _, ok := trxsMap[trxSeq]; ok {
+				continue
+			}
+			trxStatus, err := getTrxStatusBySeq(trxSeq)
+			if err != nil {
+				continue
+			}
+			if args.End != 0 && (trxStatus.BlockHeight < args.Start || trxStatus.BlockHeight > args.End) {
+				continue
+			}
+			trxsMap[trxSeq] = trxStatus
+		}
+	}
+	trxSeqs := make([]uint32, 0, len(trxsMap))
+	for trxSeq := range trxsMap {
+		trxSeqs = append(trxSeqs, trxSeq)
+	}
+	sort.Slice(trxSeqs, func(i, j int) bool { return trxSeqs[i] < trxSeqs[j] })
+	trxIds := make([]string, 0, len(trxSeqs))
+	for _, trxSeq := range trxSeqs {
+		trxIds = append(trxIds, trxsMap[trxSeq].TrxId)
+	}
+	return trxIds, nil
+}
+
+func getAddressIndexUtxos(args *AddressIndexArgs) ([]AddressIndexUtxoPrintAble, error) {
+	addresses, err := uniqueAddresses(args.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	addressIndexUtxos := []AddressIndexUtxoPrintAble{}
+	for _, addrStr := range addresses {
+		trxSeqs, err := getAddressTrxSeqs(addrStr)
+		if err != nil {
+			continue
+		}
+		utxos, err := getAddressUtxos(addrStr, trxSeqs)
+		if err != nil {
+			return nil, err
+		}
+		for _, utxo := range filterLockedUtxos(utxos) {
+			addressIndexUtxos = append(addressIndexUtxos, AddressIndexUtxoPrintAble{addrStr, utxo.TrxId,
+				utxo.Vout, utxo.ScriptPubKey, utxo.Amount, utxo.BlockHeight})
+		}
+	}
+	return addressIndexUtxos, nil
+}
+
+func getAddressIndexBalance(args *AddressIndexArgs) (AddressIndexBalancePrintAble, error) {
+	addresses, err := uniqueAddresses(args.Addresses)
+	if err != nil {
+		return AddressIndexBalancePrintAble{}, err
+	}
+	var balance AddressIndexBalancePrintAble
+	for _, addrStr := range addresses {
+		trxSeqs, err := getAddressTrxSeqs(addrStr)
+		if err != nil {
+			continue
+		}
+		stats, err := getAddressTxoStats(addrStr, trxSeqs)
+		if err != nil {
+			return AddressIndexBalancePrintAble{}, err
+		}
+		balance.Balance += stats.FundedTxoSum - stats.SpentTxoSum
+		balance.Received += stats.FundedTxoSum
+	}
+	return balance, nil
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RoleRead      = "read"
+	RoleBroadcast = "broadcast"
+	// admin is granted all the roles
+	RoleAdmin = "admin"
+)
+
+const AuthRealm = "bitcoin-spv-server"
+
+// the methods not listed here require the read role
+var methodRoles = map[string]string{
+	"SendRawTrx":    RoleBroadcast,
+	"LockUnspent":   RoleBroadcast,
+	"UnlockUnspent": RoleBroadcast,
+	"BackupDB":      RoleAdmin,
+	"VerifyIndex":   RoleAdmin,
+}
+
+type AuthPrincipal struct {
+	Name  string
+	Roles map[string]bool
+}
+
+func (a *AuthPrincipal) HasRole(role string) bool {
+	return a.Roles[RoleAdmin] || a.Roles[role]
+}
+
+type authPrincipalKey struct{}
+
+var errUnauthenticated = errors.New("unauthenticated")
+
+func isAuthEnabled() bool {
+	authConfig := &config.RpcServerConfig.Auth
+	return len(authConfig.Users) != 0 || len(authConfig.ApiKeys) != 0
+}
+
+func getMethodRole(methodName string) string {
+	role, ok := methodRoles[methodName]
+	if !ok {
+		return RoleRead
+	}
+	return role
+}
+
+// calcSecretHash is the hash of an api key stored in the config, the api keys are random so sha256 is enough
+func calcSecretHash(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func isSecretHashMatched(secret string, secretHash string) bool {
+	return subtle.ConstantTimeCompare([]byte(calcSecretHash(secret)), []byte(strings.ToLower(secretHash))) == 1
+}
+
+// calcPasswordHash is the bcrypt hash of a user password stored in the config
+func calcPasswordHash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// bcrypt is slow by design, the matched passwords are cached by their sha256 for the following requests
+var matchedPasswords sync.Map
+
+func isPasswordHashMatched(password string, passwordHash string) bool {
+	key := passwordHash + ":" + calcSecretHash(password)
+	_, ok := matchedPasswords.Load(key)
+	if ok {
+		return true
+	}
+	if bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password)) != nil {
+		return false
+	}
+	matchedPasswords.Store(key, true)
+	return true
+}
+
+// the principal of the requests without credential when auth is disabled, admin requires the auth config
+func newAnonymousPrincipal() *AuthPrincipal {
+	return newAuthPrincipal("", []string{RoleRead, RoleBroadcast})
+}
+
+func newAuthPrincipal(name string, roles []string) *AuthPrincipal {
+	principal := &AuthPrincipal{name, make(map[string]bool)}
+	for _, role := range roles {
+		principal.Roles[role] = true
+	}
+	return principal
+}
+
+// authenticate checks the basic auth user and password, or else the api key,
+// everyone is anonymous if no credential is configured
+func authenticate(user string, password string, apiKey string) (*AuthPrincipal, error) {
+	if !isAuthEnabled() {
+		return newAnonymousPrincipal(), nil
+	}
+	authConfig := &config.RpcServerConfig.Auth
+	if user != "" {
+		for _, userConfig := range authConfig.Users {
+			if userConfig.User == user && isPasswordHashMatched(password, userConfig.PasswordHash) {
+				return newAuthPrincipal(user, userConfig.Roles), nil
+			}
+		}
+		return nil, errUnauthenticated
+	}
+	if apiKey != "" {
+		for _, apiKeyConfig := range authConfig.ApiKeys {
+			if isSecretHashMatched(apiKey, apiKeyConfig.KeyHash) {
+				return newAuthPrincipal(apiKeyConfig.Label, apiKeyConfig.Roles), nil
+			}
+		}
+	}
+	return nil, errUnauthenticated
+}
+
+// parseAuthorization parses "Basic base64(user:password)" and "Bearer apikey"
+func parseAuthorization(authorization string) (string, string, string) {
+	if strings.HasPrefix(authorization, "Basic ") {
+		bytesCredential, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(authorization, "Basic "))
+		if err != nil {
+			return "", "", ""
+		}
+		credential := strings.SplitN(string(bytesCredential), ":", 2)
+		if len(credential) != 2 {
+			return "", "", ""
+		}
+		return credential[0], credential[1], ""
+	}
+	if strings.HasPrefix(authorization, "Bearer ") {
+		return "", "", strings.TrimPrefix(authorization, "Bearer ")
+	}
+	return "", "", ""
+}
+
+func authenticateHttpRequest(r *http.Request) (*AuthPrincipal, error) {
+	user, password, apiKey := parseAuthorization(r.Header.Get("Authorization"))
+	if apiKey == "" {
+		apiKey = r.Header.Get("X-API-Key")
+	}
+	return authenticate(user, password, apiKey)
+}
+
+// getAuthPrincipal returns the principal authenticated by the auth handler
+func getAuthPrincipal(r *http.Request) *AuthPrincipal {
+	principal, ok := r.Context().Value(authPrincipalKey{}).(*AuthPrincipal)
+	if ok {
+		return principal
+	}
+	if !isAuthEnabled() {
+		return newAnonymousPrincipal()
+	}
+	return newAuthPrincipal("", nil)
+}
+
+func writeUnauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", "Basic realm=\""+AuthRealm+"\"")
+	http.Error(w, "unauthorized", http.StatusUnauthorized)
+}
+
+// authHandler authenticates every request, the rest routes are authorized by the http method,
+// the rpc route "/" is authorized by the rpc method in JsonRpc2Handler
+func authHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, retryAfter, err := authenticateRateLimited(r.RemoteAddr, func() (*AuthPrincipal, error) {
+			return authenticateHttpRequest(r)
+		})
+		if err == errRateLimited {
+			writeTooManyRequests(w, retryAfter)
+			return
+		}
+		if err != nil {
+			writeUnauthorized(w)
+			return
+		}
+		if r.URL.Path != "/" {
+			role := RoleRead
+			if r.Method == "POST" {
+				role = RoleBroadcast
+			}
+			if !principal.HasRole(role) {
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), authPrincipalKey{}, principal)))
+	})
+}
+
+func authenticateGrpcContext(ctx context.Context) (*AuthPrincipal, error) {
+	var authorization, apiKey string
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if values := md.Get("authorization"); len(values) != 0 {
+			authorization = values[0]
+		}
+		if values := md.Get("x-api-key"); len(values) != 0 {
+			apiKey = values[0]
+		}
+	}
+	user, password, bearerKey := parseAuthorization(authorization)
+	if bearerKey != "" {
+		apiKey = bearerKey
+	}
+	return authenticate(user, password, apiKey)
+}
+
+func getGrpcMethodName(fullMethod string) string {
+	// the full method name is "/spv.SpvService/Method"
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+func authorizeGrpc(ctx context.Context, methodName string) (*AuthPrincipal, error) {
+	principal, retryAfter, err := authenticateRateLimited(getGrpcRemoteAddr(ctx), func() (*AuthPrincipal, error) {
+		return authenticateGrpcContext(ctx)
+	})
+	if err == errRateLimited {
+		return nil, status.Error(codes.ResourceExhausted, getRetryAfterMessage(retryAfter))
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if !principal.HasRole(getMethodRole(methodName)) {
+		return nil, status.Error(codes.PermissionDenied, "forbidden")
+	}
+	return principal, nil
+}
+
+// authUnaryInterceptor authorizes the client, then limits its rate
+func authUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	methodName := getGrpcMethodName(info.FullMethod)
+	principal, err := authorizeGrpc(ctx, methodName)
+	if err != nil {
+		return nil, err
+	}
+	err = takeGrpcUnaryRateLimit(ctx, principal, methodName)
+	if err != nil {
+		return nil, err
+	}
+	startTime := time.Now()
+	reply, err := handler(ctx, req)
+	metrics.ObserveRpc("grpc", methodName, startTime, err)
+	return reply, err
+}
+
+func authStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	methodName := getGrpcMethodName(info.FullMethod)
+	principal, err := authorizeGrpc(ss.Context(), methodName)
+	if err != nil {
+		return err
+	}
+	err = takeGrpcStreamRateLimit(ss, principal, methodName)
+	if err != nil {
+		return err
+	}
+	startTime := time.Now()
+	err = handler(srv, ss)
+	metrics.ObserveRpc("grpc", methodName, startTime, err)
+	return err
+}
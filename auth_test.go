@@ -0,0 +1,32 @@
+package main
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestParseAuthorization(t *testing.T) {
+	for _, vector := range []struct {
+		authorization string
+		user          string
+		password      string
+		apiKey        string
+	}{
+		{"Basic " + base64.StdEncoding.EncodeToString([]byte("alice:secret")), "alice", "secret", ""},
+		// the password may contain the separator
+		{"Basic " + base64.StdEncoding.EncodeToString([]byte("alice:se:cret")), "alice", "se:cret", ""},
+		{"Basic " + base64.StdEncoding.EncodeToString([]byte("alice:")), "alice", "", ""},
+		{"Basic " + base64.StdEncoding.EncodeToString([]byte("alice")), "", "", ""},
+		{"Basic not-base64", "", "", ""},
+		{"Bearer key1", "", "", "key1"},
+		{"bearer key1", "", "", ""},
+		{"Digest username=alice", "", "", ""},
+		{"", "", "", ""},
+	} {
+		user, password, apiKey := parseAuthorization(vector.authorization)
+		if user != vector.user || password != vector.password || apiKey != vector.apiKey {
+			t.Fatalf("authorization %s: %s %s %s, expected %s %s %s", vector.authorization,
+				user, password, apiKey, vector.user, vector.password, vector.apiKey)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+type BackupManifest struct {
+	BlockHeight uint32
+	TrxSequence uint32
+	DbType      string
+	BackupTime  int64
+	DBNames     []string
+}
+
+type backupDB struct {
+	name string
+	db   *DBCommon
+}
+
+var backupMutex = new(sync.Mutex)
+
+func getBackupDBs() []backupDB {
+	return []backupDB{
+		{"global_config_db", globalConfigDBMgr.db},
+		{"addr_trx_db", addrTrxsDBMgr.db},
+		{"utxo_db", utxoDBMgr.db},
+		{"trx_seq_db", trxSeqDBMgr.db},
+		{"raw_trx_db", rawTrxDBMgr.db},
+		{"trx_undo_db", trxUndoDBMgr.db},
+		{"trx_loc_db", trxLocDBMgr.db},
+		{"block_db", blockDBMgr.db},
+		{"block_hash_db", blockHashDBMgr.db},
+		{"utxo_lock_db", utxoLockDBMgr.db},
+		{"script_hash_db", scriptHashDBMgr.db},
+	}
+}
+
+func backupAllDB(dstDir string) (BackupManifest, error) {
+	backupMutex.Lock()
+	defer backupMutex.Unlock()
+
+	if dstDir == "" {
+		return BackupManifest{}, errors.New("backup dir is empty")
+	}
+	_, err := os.Stat(dstDir)
+	if err == nil {
+		return BackupManifest{}, errors.New("backup dir already exists")
+	}
+	err = os.MkdirAll(dstDir, 0755)
+	if err != nil {
+		return BackupManifest{}, err
+	}
+
+	var manifest BackupManifest
+	var tasks []*DBBackupTask
+	backupDBs := getBackupDBs()
+
+	// pause the flush, take the snapshots of all dbs at the same committed height
+	flushMutex.Lock()
+	manifest.BlockHeight, err = getStartBlockHeight()
+	if err == nil {
+		manifest.TrxSequence, err = getStartTrxSequence()
+	}
+	for _, b := range backupDBs {
+		if err != nil {
+			break
+		}
+		var task *DBBackupTask
+		task, err = b.db.DBBackupBegin(filepath.Join(dstDir, b.name))
+		if err == nil {
+			tasks = append(tasks, task)
+			manifest.DBNames = append(manifest.DBNames, b.name)
+		}
+	}
+	flushMutex.Unlock()
+
+	// copy the snapshots, the flush is not blocked any more
+	for _, task := range tasks {
+		errRun := task.Run()
+		if err == nil {
+			err = errRun
+		}
+	}
+	if err != nil {
+		return BackupManifest{}, err
+	}
+
+	// the chain index state is "0" while a block is dealing, but the backup only contains the committed data
+	globalConfigDB := new(DBCommon)
+	err = globalConfigDB.DBOpen(filepath.Join(dstDir, "global_config_db"))
+	if err != nil {
+		return BackupManifest{}, err
+	}
+	err = globalConfigDB.DBPut([]byte("chainIndexState"), []byte("1"))
+	_ = globalConfigDB.DBClose()
+	if err != nil {
+		return BackupManifest{}, err
+	}
+
+	manifest.DbType = config.DBConfig.DbType
+	manifest.BackupTime = time.Now().Unix()
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return BackupManifest{}, err
+	}
+	err = ioutil.WriteFile(filepath.Join(dstDir, "manifest.json"), manifestBytes, 0644)
+	if err != nil {
+		return BackupManifest{}, err
+	}
+	return manifest, nil
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/bigint"
+	"github.com/mutalisk999/bitcoin-lib/src/block"
+	"github.com/mutalisk999/bitcoin-lib/src/serialize"
+	"io"
+	"strconv"
+)
+
+const (
+	BlockTrxIdsPageSizeDefault = 500
+	BlockTrxIdsPageSizeMax     = 5000
+)
+
+type BlockInfo struct {
+	BlockHash   bigint.Uint256
+	Header      block.BlockHeader
+	Size        uint32
+	FirstTrxSeq uint32
+	TrxCount    uint32
+	TotalOut    int64
+	TotalFee    int64
+	HasFeeStats bool
+	FeeStats    BlockFeeStats
+}
+
+func (b BlockInfo) Pack(writer io.Writer) error {
+	err := b.BlockHash.Pack(writer)
+	if err != nil {
+		return err
+	}
+	err = b.Header.Pack(writer)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint32(writer, b.Size)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint32(writer, b.FirstTrxSeq)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint32(writer, b.TrxCount)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackInt64(writer, b.TotalOut)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackInt64(writer, b.TotalFee)
+	if err != nil {
+		return err
+	}
+	// fee stats are appended, blocks indexed by older versions have none
+	if b.HasFeeStats {
+		err = b.FeeStats.Pack(writer)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BlockInfo) UnPack(reader io.Reader) error {
+	err := b.BlockHash.UnPack(reader)
+	if err != nil {
+		return err
+	}
+	err = b.Header.UnPack(reader)
+	if err != nil {
+		return err
+	}
+	b.Size, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return err
+	}
+	b.FirstTrxSeq, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return err
+	}
+	b.TrxCount, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return err
+	}
+	b.TotalOut, err = serialize.UnPackInt64(reader)
+	if err != nil {
+		return err
+	}
+	b.TotalFee, err = serialize.UnPackInt64(reader)
+	if err != nil {
+		return err
+	}
+	err = b.FeeStats.UnPack(reader)
+	if err == io.EOF {
+		b.HasFeeStats = false
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	b.HasFeeStats = true
+	return nil
+}
+
+type BlockQueryArgs struct {
+	HeightOrHash string
+	Start        uint32
+	Count        uint32
+}
+
+type BlockPrintAble struct {
+	BlockHeight   uint32
+	BlockHash     string
+	Version       int32
+	PrevBlockHash string
+	MerkleRoot    string
+	Time          uint32
+	Bits          uint32
+	Nonce         uint32
+	Size          uint32
+	Confirmations uint32
+	TrxCount      uint32
+	TotalOut      int64
+	TotalFee      int64
+	Start         uint32
+	TrxIds        []string
+}
+
+func (b *BlockInfo) GetBlockPrintAble(blockHeight uint32) BlockPrintAble {
+	var blockPrintAble BlockPrintAble
+	blockPrintAble.BlockHeight = blockHeight
+	blockPrintAble.BlockHash = b.BlockHash.GetHex()
+	blockPrintAble.Version = b.Header.Version
+	blockPrintAble.PrevBlockHash = b.Header.HashPrevBlock.GetHex()
+	blockPrintAble.MerkleRoot = b.Header.HashMerkleRoot.GetHex()
+	blockPrintAble.Time = b.Header.Time
+	blockPrintAble.Bits = b.Header.Bits
+	blockPrintAble.Nonce = b.Header.Nonce
+	blockPrintAble.Size = b.Size
+	// confirmed by the flushed blocks like the trx status
+	flushedHeight := getFlushedBlockHeight()
+	if flushedHeight >= blockHeight {
+		blockPrintAble.Confirmations = flushedHeight - blockHeight + 1
+	}
+	blockPrintAble.TrxCount = b.TrxCount
+	blockPrintAble.TotalOut = b.TotalOut
+	blockPrintAble.TotalFee = b.TotalFee
+	blockPrintAble.TrxIds = []string{}
+	return blockPrintAble
+}
+
+func getBlockInfo(heightOrHash string) (uint32, BlockInfo, error) {
+	var blockHeight uint32
+	if len(heightOrHash) == 64 {
+		var blockHash bigint.Uint256
+		err := blockHash.SetHex(heightOrHash)
+		if err != nil {
+			return 0, BlockInfo{}, err
+		}
+		blockHeight, err = blockHashDBMgr.DBGet(blockHash)
+		if err != nil {
+			return 0, BlockInfo{}, newNotFoundError("block hash")
+		}
+	} else {
+		ui64, err := strconv.ParseUint(heightOrHash, 10, 32)
+		if err != nil {
+			return 0, BlockInfo{}, errors.New("invalid block height or hash")
+		}
+		blockHeight = uint32(ui64)
+	}
+	blockInfo, err := blockDBMgr.DBGet(blockHeight)
+	if err != nil {
+		return 0, BlockInfo{}, newNotFoundError("block height")
+	}
+	return blockHeight, blockInfo, nil
+}
+
+func getBlockTrxIds(blockInfo *BlockInfo, start uint32, count uint32) ([]string, error) {
+	trxIds := []string{}
+	if count == 0 {
+		count = BlockTrxIdsPageSizeDefault
+	}
+	if count > BlockTrxIdsPageSizeMax {
+		count = BlockTrxIdsPageSizeMax
+	}
+	if start >= blockInfo.TrxCount {
+		return trxIds, nil
+	}
+	// clamp first, start+count could overflow
+	if count > blockInfo.TrxCount-start {
+		count = blockInfo.TrxCount - start
+	}
+	for i := start; i < start+count; i++ {
+		trxId, err := trxSeqDBMgr.DBGet(blockInfo.FirstTrxSeq + i)
+		if err != nil {
+			return nil, newNotFoundError("trx seq")
+		}
+		trxIds = append(trxIds, trxId.GetHex())
+	}
+	return trxIds, nil
+}
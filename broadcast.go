@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"github.com/mutalisk999/bitcoin-lib/src/transaction"
+	"io"
+	"strconv"
+)
+
+const (
+	MaxMoney = 21000000 * 100000000
+
+	RejectDecodeFailed      = "decode-failed"
+	RejectEmptyVin          = "bad-txns-vin-empty"
+	RejectEmptyVout         = "bad-txns-vout-empty"
+	RejectCoinBase          = "coinbase"
+	RejectDuplicateInputs   = "bad-txns-inputs-duplicate"
+	RejectOutputValue       = "bad-txns-vout-value"
+	RejectAlreadyKnown      = "txn-already-known"
+	RejectMissingInputs     = "missing-inputs"
+	RejectInsufficientInput = "bad-txns-in-belowout"
+	RejectFeeRateTooLow     = "min-fee-rate-not-met"
+	RejectRelayUnavailable  = "relay-unavailable"
+	RejectRelayFailed       = "relay-failed"
+)
+
+type SendRawTrxPrintAble struct {
+	TrxId         string
+	Accepted      bool
+	RejectCode    string
+	RejectReason  string
+	MissingInputs []UtxoSourcePrintAble
+	ValueIn       int64
+	ValueOut      int64
+	Fee           int64
+	VSize         int
+	FeeRate       float64
+}
+
+func (s *SendRawTrxPrintAble) reject(code string, reason string) {
+	s.Accepted = false
+	s.RejectCode = code
+	s.RejectReason = reason
+}
+
+// getUnspentUtxo finds the utxo in the slot cache which is not flushed yet, then in utxo_db
+func getUnspentUtxo(utxoSrc UtxoSource) (UtxoDetail, bool) {
+	if slotCache.IsUtxoDeleted(utxoSrc) {
+		return UtxoDetail{}, false
+	}
+	utxoDetail, ok := slotCache.GetUtxo(utxoSrc)
+	if ok {
+		return utxoDetail, true
+	}
+	utxoDetail, err := utxoDBMgr.DBGet(utxoSrc)
+	if err != nil {
+		return UtxoDetail{}, false
+	}
+	return utxoDetail, true
+}
+
+func validateRawTrx(rawTrxHex string, result *SendRawTrxPrintAble) (*transaction.Transaction, bool) {
+	rawTrxBytes, err := hex.DecodeString(rawTrxHex)
+	if err != nil {
+		result.reject(RejectDecodeFailed, "invalid hex string")
+		return nil, false
+	}
+	trx := new(transaction.Transaction)
+	reader := bytes.NewReader(rawTrxBytes)
+	err = trx.UnPack(io.Reader(reader))
+	if err != nil || reader.Len() != 0 {
+		result.reject(RejectDecodeFailed, "invalid raw transaction")
+		return nil, false
+	}
+	trxId, err := trx.CalcTrxId()
+	if err != nil {
+		result.reject(RejectDecodeFailed, err.Error())
+		return nil, false
+	}
+	result.TrxId = trxId.GetHex()
+	_, result.VSize, _, err = calcTrxSize(trx)
+	if err != nil {
+		result.reject(RejectDecodeFailed, err.Error())
+		return nil, false
+	}
+
+	// context free checks
+	if len(trx.Vin) == 0 {
+		result.reject(RejectEmptyVin, "transaction has no inputs")
+		return nil, false
+	}
+	if len(trx.Vout) == 0 {
+		result.reject(RejectEmptyVout, "transaction has no outputs")
+		return nil, false
+	}
+	if isCoinBaseTrx(trx) {
+		result.reject(RejectCoinBase, "coinbase transaction can not be broadcast")
+		return nil, false
+	}
+	for index, vout := range trx.Vout {
+		if vout.Value < 0 || vout.Value > MaxMoney {
+			result.reject(RejectOutputValue, "invalid value of output "+strconv.Itoa(index))
+			return nil, false
+		}
+		result.ValueOut += vout.Value
+		if result.ValueOut > MaxMoney {
+			result.reject(RejectOutputValue, "total output value out of range")
+			return nil, false
+		}
+	}
+
+	// inputs must be unspent in the index
+	_, err = trxLocDBMgr.DBGet(trxId)
+	if err == nil {
+		result.reject(RejectAlreadyKnown, "transaction is already in block")
+		return nil, false
+	}
+	inputsMap := make(map[string]bool)
+	for _, vin := range trx.Vin {
+		utxoSrc := UtxoSource{vin.PrevOut.Hash, vin.PrevOut.N}
+		utxoSrcStr, err := utxoSrc.ToStreamString()
+		if err != nil {
+			result.reject(RejectDecodeFailed, err.Error())
+			return nil, false
+		}
+		if inputsMap[utxoSrcStr] {
+			result.reject(RejectDuplicateInputs, "input "+vin.PrevOut.Hash.GetHex()+":"+strconv.Itoa(int(vin.PrevOut.N))+" is spent twice")
+			return nil, false
+		}
+		inputsMap[utxoSrcStr] = true
+		utxoDetail, ok := getUnspentUtxo(utxoSrc)
+		if !ok {
+			result.MissingInputs = append(result.MissingInputs, utxoSrc.GetUtxoSourcePrintAble())
+			continue
+		}
+		result.ValueIn += utxoDetail.Amount
+	}
+	if len(result.MissingInputs) != 0 {
+		result.reject(RejectMissingInputs, strconv.Itoa(len(result.MissingInputs))+" inputs are missing or spent")
+		return nil, false
+	}
+
+	// fee
+	if result.ValueOut > result.ValueIn {
+		result.reject(RejectInsufficientInput, "output value "+strconv.FormatInt(result.ValueOut, 10)+" exceeds input value "+strconv.FormatInt(result.ValueIn, 10))
+		return nil, false
+	}
+	result.Fee = result.ValueIn - result.ValueOut
+	result.FeeRate = float64(result.Fee) / float64(result.VSize)
+	if result.FeeRate < config.BroadcastConfig.MinFeeRate {
+		result.reject(RejectFeeRateTooLow, "fee rate "+strconv.FormatFloat(result.FeeRate, 'f', 3, 64)+" sat/vB is lower than "+
+			strconv.FormatFloat(config.BroadcastConfig.MinFeeRate, 'f', 3, 64)+" sat/vB")
+		return nil, false
+	}
+	return trx, true
+}
+
+func relayRawTrx(rawTrxHex string) (string, error) {
+	rpcResponse, err := doHttpJsonRpcCallType1("sendrawtransaction", rawTrxHex)
+	if err != nil {
+		return "", err
+	}
+	if rpcResponse.Error != nil {
+		return "", rpcResponse.Error
+	}
+	return rpcResponse.GetString()
+}
+
+func sendRawTrx(rawTrxHex string) SendRawTrxPrintAble {
+	var result SendRawTrxPrintAble
+	_, ok := validateRawTrx(rawTrxHex, &result)
+	if !ok {
+		return result
+	}
+	if config.RpcClientConfig.BtcWallet.RpcReqUrl == "" {
+		result.reject(RejectRelayUnavailable, "btcWallet rpc url is not configured")
+		return result
+	}
+	_, err := relayRawTrx(rawTrxHex)
+	if err != nil {
+		result.reject(RejectRelayFailed, err.Error())
+		return result
+	}
+	result.Accepted = true
+	return result
+}
@@ -1,34 +1,62 @@
 package main
 
 import (
+	"container/list"
 	"sync"
 )
 
 type SlotCache struct {
 	AddrTrxsAdd map[string]map[uint32]uint32
 	UtxosAdd    map[string]UtxoDetail
-	UtxosDel    map[string]uint32
+	UtxosDel    map[string]UtxoDetail
 	TrxSeqAdd   map[uint32]string
 	RawTrxsAdd  map[string][]byte
+	TrxUndosAdd map[string][]SpentUtxo
+	TrxLocsAdd  map[string]TrxLocation
+	BlocksAdd   map[uint32]BlockInfo
 	Mutex       *sync.Mutex
 }
 
 func (s *SlotCache) Initialize() {
 	s.AddrTrxsAdd = make(map[string]map[uint32]uint32)
 	s.UtxosAdd = make(map[string]UtxoDetail)
-	s.UtxosDel = make(map[string]uint32)
+	s.UtxosDel = make(map[string]UtxoDetail)
 	s.TrxSeqAdd = make(map[uint32]string)
 	s.RawTrxsAdd = make(map[string][]byte)
+	s.TrxUndosAdd = make(map[string][]SpentUtxo)
+	s.TrxLocsAdd = make(map[string]TrxLocation)
+	s.BlocksAdd = make(map[uint32]BlockInfo)
 	s.Mutex = new(sync.Mutex)
 }
 
+// Clear empties the maps in place under the lock, the readers always see the same maps and mutex
 func (s *SlotCache) Clear() {
-	s.AddrTrxsAdd = make(map[string]map[uint32]uint32)
-	s.UtxosAdd = make(map[string]UtxoDetail)
-	s.UtxosDel = make(map[string]uint32)
-	s.TrxSeqAdd = make(map[uint32]string)
-	s.RawTrxsAdd = make(map[string][]byte)
-	s.Mutex = new(sync.Mutex)
+	s.Mutex.Lock()
+	for k := range s.AddrTrxsAdd {
+		delete(s.AddrTrxsAdd, k)
+	}
+	for k := range s.UtxosAdd {
+		delete(s.UtxosAdd, k)
+	}
+	for k := range s.UtxosDel {
+		delete(s.UtxosDel, k)
+	}
+	for k := range s.TrxSeqAdd {
+		delete(s.TrxSeqAdd, k)
+	}
+	for k := range s.RawTrxsAdd {
+		delete(s.RawTrxsAdd, k)
+	}
+	for k := range s.TrxUndosAdd {
+		delete(s.TrxUndosAdd, k)
+	}
+	for k := range s.TrxLocsAdd {
+		delete(s.TrxLocsAdd, k)
+	}
+	for k := range s.BlocksAdd {
+		delete(s.BlocksAdd, k)
+	}
+	s.Mutex.Unlock()
 }
 
 func (s *SlotCache) AddAddrTrx(addrStr string, trxSeq uint32) {
@@ -53,6 +81,17 @@ func (s *SlotCache) GetUtxo(utxoSrc UtxoSource) (UtxoDetail, bool) {
 	return utxoDetail, ok
 }
 
+func (s *SlotCache) IsUtxoDeleted(utxoSrc UtxoSource) bool {
+	utxoSrcStr, err := utxoSrc.ToStreamString()
+	if err != nil {
+		return false
+	}
+	s.Mutex.Lock()
+	_, ok := s.UtxosDel[utxoSrcStr]
+	s.Mutex.Unlock()
+	return ok
+}
+
 func (s *SlotCache) AddUtxo(utxoSrc UtxoSource, utxoDetail UtxoDetail) error {
 	utxoSrcStr, err := utxoSrc.ToStreamString()
 	if err != nil {
@@ -64,7 +103,7 @@ func (s *SlotCache) AddUtxo(utxoSrc UtxoSource, utxoDetail UtxoDetail) error {
 	return nil
 }
 
-func (s *SlotCache) DelUtxo(utxoSrc UtxoSource) error {
+func (s *SlotCache) DelUtxo(utxoSrc UtxoSource, utxoDetail UtxoDetail) error {
 	utxoSrcStr, err := utxoSrc.ToStreamString()
 	if err != nil {
 		return err
@@ -74,7 +113,7 @@ func (s *SlotCache) DelUtxo(utxoSrc UtxoSource) error {
 	if ok {
 		delete(s.UtxosAdd, utxoSrcStr)
 	} else {
-		s.UtxosDel[utxoSrcStr] = 0
+		s.UtxosDel[utxoSrcStr] = utxoDetail
 	}
 	s.Mutex.Unlock()
 	return nil
@@ -92,25 +131,108 @@ func (s *SlotCache) AddRawTrx(trxIdStr string, rawTrxData []byte) {
 	s.Mutex.Unlock()
 }
 
+func (s *SlotCache) AddTrxUndo(trxIdStr string, spentUtxo SpentUtxo) {
+	s.Mutex.Lock()
+	s.TrxUndosAdd[trxIdStr] = append(s.TrxUndosAdd[trxIdStr], spentUtxo)
+	s.Mutex.Unlock()
+}
+
+func (s *SlotCache) AddTrxLoc(trxIdStr string, trxLocation TrxLocation) {
+	s.Mutex.Lock()
+	s.TrxLocsAdd[trxIdStr] = trxLocation
+	s.Mutex.Unlock()
+}
+
+func (s *SlotCache) AddBlock(blockHeight uint32, blockInfo BlockInfo) {
+	s.Mutex.Lock()
+	s.BlocksAdd[blockHeight] = blockInfo
+	s.Mutex.Unlock()
+}
+
 func (s *SlotCache) CalcObjectCacheWeight() int64 {
 	var addrTrxsWeight int64 = 0
 	var utxosWeight int64 = 0
 	var trxSeqWeight int64 = 0
 	var rawTrxsWeight int64 = 0
+	var trxUndosWeight int64 = 0
+	var trxLocsWeight int64 = 0
+	var blocksWeight int64 = 0
 	var totalWeight int64 = 0
 
 	s.Mutex.Lock()
 	for _, v := range s.AddrTrxsAdd {
 		addrTrxsWeight = addrTrxsWeight + int64(30) + int64(8)*int64(len(v))
 	}
-	utxosWeight = int64(108)*int64(len(s.UtxosAdd)) + int64(36)*int64(len(s.UtxosDel))
+	utxosWeight = int64(108)*int64(len(s.UtxosAdd)) + int64(108)*int64(len(s.UtxosDel))
 	trxSeqWeight = int64(36) * int64(len(s.TrxSeqAdd))
 	for _, v := range s.RawTrxsAdd {
 		rawTrxsWeight = rawTrxsWeight + int64(32) + int64(len(v))
 	}
-	totalWeight = addrTrxsWeight + utxosWeight + trxSeqWeight + rawTrxsWeight
+	for _, v := range s.TrxUndosAdd {
+		trxUndosWeight = trxUndosWeight + int64(32) + int64(144)*int64(len(v))
+	}
+	trxLocsWeight = int64(76) * int64(len(s.TrxLocsAdd))
+	blocksWeight = int64(172) * int64(len(s.BlocksAdd))
+	totalWeight = addrTrxsWeight + utxosWeight + trxSeqWeight + rawTrxsWeight + trxUndosWeight + trxLocsWeight + blocksWeight
 	s.Mutex.Unlock()
 	return totalWeight
 }
 
 var slotCache *SlotCache
+
+const (
+	RawTrxCacheCountDefault = 10000
+)
+
+// RawTrxCache is a bounded LRU cache of the raw trxs fetched from the data source
+type RawTrxCache struct {
+	CountMax int
+	Items    map[string]*list.Element
+	List     *list.List
+	Mutex    *sync.Mutex
+}
+
+type rawTrxCacheEntry struct {
+	trxIdStr   string
+	rawTrxData []byte
+}
+
+func (r *RawTrxCache) Initialize(countMax int) {
+	if countMax <= 0 {
+		countMax = RawTrxCacheCountDefault
+	}
+	r.CountMax = countMax
+	r.Items = make(map[string]*list.Element)
+	r.List = list.New()
+	r.Mutex = new(sync.Mutex)
+}
+
+func (r *RawTrxCache) Get(trxIdStr string) ([]byte, bool) {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	elem, ok := r.Items[trxIdStr]
+	if !ok {
+		return nil, false
+	}
+	r.List.MoveToFront(elem)
+	return elem.Value.(*rawTrxCacheEntry).rawTrxData, true
+}
+
+func (r *RawTrxCache) Add(trxIdStr string, rawTrxData []byte) {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	elem, ok := r.Items[trxIdStr]
+	if ok {
+		elem.Value.(*rawTrxCacheEntry).rawTrxData = rawTrxData
+		r.List.MoveToFront(elem)
+		return
+	}
+	r.Items[trxIdStr] = r.List.PushFront(&rawTrxCacheEntry{trxIdStr, rawTrxData})
+	for r.List.Len() > r.CountMax {
+		elem = r.List.Back()
+		r.List.Remove(elem)
+		delete(r.Items, elem.Value.(*rawTrxCacheEntry).trxIdStr)
+	}
+}
+
+var rawTrxCache *RawTrxCache
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func appCli(args []string) (bool, error) {
+	if args[0] == "exportsnapshot" && len(args) == 2 {
+		blockHeight, err := exportSnapshot(args[1])
+		if err != nil {
+			return false, err
+		}
+		fmt.Println("snapshot exported at block height:", blockHeight)
+		return false, nil
+	} else if args[0] == "importsnapshot" && len(args) == 2 {
+		blockHeight, err := importSnapshot(args[1])
+		if err != nil {
+			return false, err
+		}
+		fmt.Println("snapshot imported at block height:", blockHeight)
+		// resume gathering from the height of the snapshot
+		return true, nil
+	} else if args[0] == "verify" {
+		verifyArgs, err := parseVerifyArgs(args[1:])
+		if err != nil {
+			return false, err
+		}
+		report, err := verifyIndexDB(verifyArgs)
+		if err != nil {
+			return false, err
+		}
+		printVerifyReport(&report)
+		return false, nil
+	} else if args[0] == "reindex" {
+		blockHeight, err := parseReindexArgs(args[1:])
+		if err != nil {
+			return false, err
+		}
+		err = reindexFromHeight(blockHeight)
+		if err != nil {
+			return false, err
+		}
+		fmt.Println("index rolled back to block height:", blockHeight)
+		// resume gathering from the height of the reindex
+		return true, nil
+	} else if args[0] == "hashsecret" && len(args) == 2 {
+		// the hash of an api key for the auth config
+		fmt.Println(calcSecretHash(args[1]))
+		return false, nil
+	} else if args[0] == "hashpassword" && len(args) == 2 {
+		// the hash of a user password for the auth config
+		passwordHash, err := calcPasswordHash(args[1])
+		if err != nil {
+			return false, err
+		}
+		fmt.Println(passwordHash)
+		return false, nil
+	}
+	return false, errors.New("not support command: " + strings.Join(args, " "))
+}
+
+func appDBClose() {
+	// wait for the background writers
+	flushMutex.Lock()
+	defer flushMutex.Unlock()
+	_ = globalConfigDBMgr.DBClose()
+	_ = addrTrxsDBMgr.DBClose()
+	_ = utxoDBMgr.DBClose()
+	_ = trxSeqDBMgr.DBClose()
+	_ = rawTrxDBMgr.DBClose()
+	_ = trxUndoDBMgr.DBClose()
+	_ = trxLocDBMgr.DBClose()
+	_ = blockDBMgr.DBClose()
+	_ = blockHashDBMgr.DBClose()
+	_ = utxoLockDBMgr.DBClose()
+	_ = scriptHashDBMgr.DBClose()
+}
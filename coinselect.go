@@ -0,0 +1,258 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/bigint"
+	"github.com/mutalisk999/bitcoin-lib/src/serialize"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	CoinSelectBnBTriesMax        = 100000
+	CoinSelectKnapsackIterations = 1000
+	CoinBaseMaturity             = 100
+	DustRelayFeeRate             = 3
+
+	// weight of version, locktime, vin count and vout count
+	TrxOverheadWeight     = 40
+	TrxSegWitMarkerWeight = 2
+)
+
+const (
+	CoinSelectAlgorithmBnB      = "bnb"
+	CoinSelectAlgorithmKnapsack = "knapsack"
+)
+
+type coinCandidate struct {
+	utxo           UtxoPrintAble
+	scriptPubKey   []byte
+	inputWeight    int
+	isWitness      bool
+	effectiveValue int64
+}
+
+// getInputWeight estimates the weight to spend the script with a compressed key and a 72 bytes signature,
+// the redeem script of p2sh is unknown, p2sh is estimated only with the p2sh-p2wpkh script type hint
+func getInputWeight(scriptBytes []byte, p2shScriptType string) (int, bool, error) {
+	scriptLen := len(scriptBytes)
+	if scriptLen == 25 && scriptBytes[0] == 0x76 && scriptBytes[1] == 0xa9 && scriptBytes[2] == 0x14 &&
+		scriptBytes[23] == 0x88 && scriptBytes[24] == 0xac {
+		// outpoint 36, script sig 1 + 107, sequence 4
+		return 148 * 4, false, nil
+	} else if scriptLen == 23 && scriptBytes[0] == 0xa9 && scriptBytes[1] == 0x14 && scriptBytes[22] == 0x87 {
+		if p2shScriptType != XpubScriptTypeP2SHWPK {
+			return 0, false, errors.New("not support estimating the input size of p2sh without the script type")
+		}
+		// script sig pushes the 22 bytes redeem script, witness is the same as p2wpkh
+		return (36+1+23+4)*4 + 108, true, nil
+	} else if scriptLen == 22 && scriptBytes[0] == 0x00 && scriptBytes[1] == 0x14 {
+		// witness count 1, signature 1 + 72, pubkey 1 + 33
+		return (36+1+4)*4 + 108, true, nil
+	} else if scriptLen == 34 && scriptBytes[0] == 0x51 && scriptBytes[1] == 0x20 {
+		// key path spend, witness count 1, schnorr signature 1 + 64
+		return (36+1+4)*4 + 66, true, nil
+	}
+	return 0, false, errors.New("not support estimating the input size of script")
+}
+
+func getOutputWeight(scriptBytes []byte) int {
+	return (8 + int(serialize.CompactSizeLen(uint64(len(scriptBytes)))) + len(scriptBytes)) * 4
+}
+
+func isWitnessScript(scriptBytes []byte) bool {
+	scriptLen := len(scriptBytes)
+	if scriptLen < 4 || scriptLen > 42 {
+		return false
+	}
+	if scriptBytes[0] != 0x00 && (scriptBytes[0] < 0x51 || scriptBytes[0] > 0x60) {
+		return false
+	}
+	return int(scriptBytes[1])+2 == scriptLen
+}
+
+// getDustThreshold is the value which costs more than 1/3 of itself to spend at the dust relay fee rate
+func getDustThreshold(scriptBytes []byte) int64 {
+	size := getOutputWeight(scriptBytes) / 4
+	if isWitnessScript(scriptBytes) {
+		size += 32 + 4 + 1 + 107/4 + 4
+	} else {
+		size += 32 + 4 + 1 + 107 + 4
+	}
+	return int64(size) * DustRelayFeeRate
+}
+
+func getFeeOfWeight(weight int, feeRate float64) int64 {
+	return int64(math.Ceil(float64(weight) * feeRate / 4))
+}
+
+// getCoinCandidates skips the immature coinbase utxos, the utxos which cost more than their value to spend
+// and the utxos whose input size or trx location is unknown
+func getCoinCandidates(utxos []UtxoPrintAble, feeRate float64, p2shScriptType string) ([]coinCandidate, error) {
+	candidates := make([]coinCandidate, 0, len(utxos))
+	for _, utxo := range utxos {
+		var candidate coinCandidate
+		candidate.utxo = utxo
+		scriptBytes, err := hex.DecodeString(utxo.ScriptPubKey)
+		if err != nil {
+			return nil, err
+		}
+		candidate.scriptPubKey = scriptBytes
+		candidate.inputWeight, candidate.isWitness, err = getInputWeight(scriptBytes, p2shScriptType)
+		if err != nil {
+			continue
+		}
+		candidate.effectiveValue = utxo.Amount - getFeeOfWeight(candidate.inputWeight, feeRate)
+		if candidate.effectiveValue <= 0 {
+			continue
+		}
+		var trxId bigint.Uint256
+		err = trxId.SetHex(utxo.TrxId)
+		if err != nil {
+			return nil, err
+		}
+		trxLocation, err := trxLocDBMgr.DBGet(trxId)
+		if err != nil {
+			continue
+		}
+		if trxLocation.BlockIndex == 0 && startBlockHeight+1 < utxo.BlockHeight+CoinBaseMaturity {
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+// selectCoinsBnB searches for an input set matching the target without change (branch and bound),
+// the excess over the target must be lower than the cost of change and is minimized
+func selectCoinsBnB(candidates []coinCandidate, target int64, costOfChange int64) ([]int, bool) {
+	sorted := make([]int, len(candidates))
+	for i := range sorted {
+		sorted[i] = i
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return candidates[sorted[i]].effectiveValue > candidates[sorted[j]].effectiveValue
+	})
+	available := make([]int64, len(sorted)+1)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		available[i] = available[i+1] + candidates[sorted[i]].effectiveValue
+	}
+
+	var best []int
+	var bestExcess int64 = -1
+	var selected []int
+	tries := 0
+	var search func(index int, value int64)
+	search = func(index int, value int64) {
+		if tries >= CoinSelectBnBTriesMax || bestExcess == 0 {
+			return
+		}
+		tries += 1
+		if value > target+costOfChange {
+			return
+		}
+		if value >= target {
+			if bestExcess < 0 || value-target < bestExcess {
+				bestExcess = value - target
+				best = append([]int{}, selected...)
+			}
+			return
+		}
+		if index >= len(sorted) || value+available[index] < target {
+			return
+		}
+		selected = append(selected, sorted[index])
+		search(index+1, value+candidates[sorted[index]].effectiveValue)
+		selected = selected[:len(selected)-1]
+		// omitting the candidate, including an equal one next is the same as including it
+		next := index + 1
+		for next < len(sorted) && candidates[sorted[next]].effectiveValue == candidates[sorted[index]].effectiveValue {
+			next += 1
+		}
+		search(next, value)
+	}
+	search(0, 0)
+	if bestExcess < 0 {
+		return nil, false
+	}
+	return best, true
+}
+
+// approximateBestSubset runs the randomized stochastic approximation of the knapsack solver
+func approximateBestSubset(candidates []coinCandidate, indexes []int, totalLower int64, target int64) ([]bool, int64) {
+	best := make([]bool, len(indexes))
+	for i := range best {
+		best[i] = true
+	}
+	bestValue := totalLower
+	included := make([]bool, len(indexes))
+	for rep := 0; rep < CoinSelectKnapsackIterations && bestValue != target; rep++ {
+		for i := range included {
+			included[i] = false
+		}
+		var total int64
+		reachedTarget := false
+		for pass := 0; pass < 2 && !reachedTarget; pass++ {
+			for i, index := range indexes {
+				// the first pass picks randomly, the second pass fills the remaining
+				if (pass == 0 && rand.Intn(2) == 1) || (pass == 1 && !included[i]) {
+					total += candidates[index].effectiveValue
+					included[i] = true
+					if total >= target {
+						reachedTarget = true
+						if total < bestValue {
+							bestValue = total
+							copy(best, included)
+						}
+						total -= candidates[index].effectiveValue
+						included[i] = false
+					}
+				}
+			}
+		}
+	}
+	return best, bestValue
+}
+
+// selectCoinsKnapsack is the fallback when no changeless match exists, the selection exceeds the target
+func selectCoinsKnapsack(candidates []coinCandidate, target int64) ([]int, bool) {
+	var lowerIndexes []int
+	var totalLower int64
+	lowestLarger := -1
+	for i, candidate := range candidates {
+		if candidate.effectiveValue == target {
+			return []int{i}, true
+		} else if candidate.effectiveValue < target {
+			lowerIndexes = append(lowerIndexes, i)
+			totalLower += candidate.effectiveValue
+		} else if lowestLarger < 0 || candidate.effectiveValue < candidates[lowestLarger].effectiveValue {
+			lowestLarger = i
+		}
+	}
+	if totalLower == target {
+		return lowerIndexes, true
+	}
+	if totalLower < target {
+		if lowestLarger < 0 {
+			return nil, false
+		}
+		return []int{lowestLarger}, true
+	}
+
+	sort.SliceStable(lowerIndexes, func(i, j int) bool {
+		return candidates[lowerIndexes[i]].effectiveValue > candidates[lowerIndexes[j]].effectiveValue
+	})
+	best, bestValue := approximateBestSubset(candidates, lowerIndexes, totalLower, target)
+	if lowestLarger >= 0 && bestValue != target && candidates[lowestLarger].effectiveValue <= bestValue {
+		return []int{lowestLarger}, true
+	}
+	var selected []int
+	for i, index := range lowerIndexes {
+		if best[i] {
+			selected = append(selected, index)
+		}
+	}
+	return selected, true
+}
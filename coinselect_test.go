@@ -0,0 +1,130 @@
+package main
+
+import (
+	"sort"
+	"testing"
+)
+
+func coinSelectTestCandidates(values ...int64) []coinCandidate {
+	candidates := make([]coinCandidate, len(values))
+	for i, value := range values {
+		candidates[i].effectiveValue = value
+	}
+	return candidates
+}
+
+func coinSelectTestValues(candidates []coinCandidate, selected []int) []int64 {
+	values := make([]int64, 0, len(selected))
+	for _, index := range selected {
+		values = append(values, candidates[index].effectiveValue)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+	return values
+}
+
+func coinSelectTestEqual(values []int64, expected []int64) bool {
+	if len(values) != len(expected) {
+		return false
+	}
+	for i := range values {
+		if values[i] != expected[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// the cases follow the bnb tests of coinselector_tests.cpp of bitcoin core
+func TestSelectCoinsBnB(t *testing.T) {
+	candidates := coinSelectTestCandidates(100, 200, 300, 400)
+	for _, vector := range []struct {
+		target       int64
+		costOfChange int64
+		expected     []int64
+	}{
+		{100, 0, []int64{100}},
+		{300, 0, []int64{300}},
+		{500, 0, []int64{100, 400}},
+		{1000, 0, []int64{100, 200, 300, 400}},
+		// the excess is within the cost of change
+		{350, 50, []int64{400}},
+	} {
+		selected, ok := selectCoinsBnB(candidates, vector.target, vector.costOfChange)
+		if !ok {
+			t.Fatalf("target %d: no selection", vector.target)
+		}
+		values := coinSelectTestValues(candidates, selected)
+		if !coinSelectTestEqual(values, vector.expected) {
+			t.Fatalf("target %d: selection %v, expected %v", vector.target, values, vector.expected)
+		}
+	}
+
+	for _, vector := range []struct {
+		target       int64
+		costOfChange int64
+	}{
+		// more than the total value
+		{1100, 0},
+		// no exact match without the cost of change
+		{350, 0},
+		{350, 49},
+	} {
+		_, ok := selectCoinsBnB(candidates, vector.target, vector.costOfChange)
+		if ok {
+			t.Fatalf("target %d cost of change %d: unexpected selection", vector.target, vector.costOfChange)
+		}
+	}
+}
+
+func TestSelectCoinsKnapsack(t *testing.T) {
+	for _, vector := range []struct {
+		values   []int64
+		target   int64
+		expected []int64
+	}{
+		// an exact match
+		{[]int64{100, 300, 500}, 300, []int64{300}},
+		// the lower values sum to the target
+		{[]int64{100, 200, 1000}, 300, []int64{100, 200}},
+		// the lower values are not enough, the lowest larger one is taken
+		{[]int64{100, 200, 1000, 2000}, 500, []int64{1000}},
+		// the subset of the lower values is exact
+		{[]int64{600, 500, 300, 5000}, 800, []int64{300, 500}},
+		// no exact subset, the lowest larger one is lower than the best subset
+		{[]int64{400, 400, 400, 1100}, 1000, []int64{1100}},
+		// no exact subset, the best subset is lower than the lowest larger one
+		{[]int64{400, 400, 400, 2000}, 1000, []int64{400, 400, 400}},
+	} {
+		candidates := coinSelectTestCandidates(vector.values...)
+		selected, ok := selectCoinsKnapsack(candidates, vector.target)
+		if !ok {
+			t.Fatalf("values %v target %d: no selection", vector.values, vector.target)
+		}
+		values := coinSelectTestValues(candidates, selected)
+		if !coinSelectTestEqual(values, vector.expected) {
+			t.Fatalf("values %v target %d: selection %v, expected %v", vector.values, vector.target, values, vector.expected)
+		}
+	}
+
+	_, ok := selectCoinsKnapsack(coinSelectTestCandidates(100, 200), 400)
+	if ok {
+		t.Fatal("unexpected selection of insufficient values")
+	}
+}
+
+func TestGetInputWeight(t *testing.T) {
+	p2sh := append(append([]byte{0xa9, 0x14}, make([]byte, 20)...), 0x87)
+	_, _, err := getInputWeight(p2sh, "")
+	if err == nil {
+		t.Fatal("p2sh without the script type is estimated")
+	}
+	weight, isWitness, err := getInputWeight(p2sh, XpubScriptTypeP2SHWPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if weight != 364 || !isWitness {
+		t.Fatalf("p2sh-p2wpkh weight %d witness %v", weight, isWitness)
+	}
+}
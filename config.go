@@ -14,10 +14,14 @@ type CacheConfig struct {
 	FlushCacheOnQuit     bool   `json:"flushCacheOnQuit"`
 	SamplingBlockCount   uint32 `json:"samplingBlockCount"`
 	ObjectCacheWeightMax int64  `json:"objectCacheWeightMax"`
+	RawTrxCacheCount     int    `json:"rawTrxCacheCount"`
 }
 
 type GatherConfig struct {
-	StoreRawTrx bool `json:"storeRawTrx"`
+	Network           string `json:"network"`
+	StoreRawTrx       bool   `json:"storeRawTrx"`
+	StoreTrxUndo      bool   `json:"storeTrxUndo"`
+	PruneRawTrxBlocks uint32 `json:"pruneRawTrxBlocks"`
 }
 
 type BtcWalletConfig struct {
@@ -34,8 +38,40 @@ type RpcClientConfig struct {
 	RawBlock   RawBlockConfig  `json:"rawBlock"`
 }
 
+type AuthUserConfig struct {
+	User         string   `json:"user"`
+	PasswordHash string   `json:"passwordHash"`
+	Roles        []string `json:"roles"`
+}
+
+type AuthApiKeyConfig struct {
+	Label   string   `json:"label"`
+	KeyHash string   `json:"keyHash"`
+	Roles   []string `json:"roles"`
+}
+
+type AuthConfig struct {
+	Users   []AuthUserConfig   `json:"users"`
+	ApiKeys []AuthApiKeyConfig `json:"apiKeys"`
+}
+
+type RateLimitConfig struct {
+	RequestsPerSecond float64            `json:"requestsPerSecond"`
+	Burst             float64            `json:"burst"`
+	MethodCosts       map[string]float64 `json:"methodCosts"`
+}
+
 type RpcServerConfig struct {
-	RpcListenEndPoint string `json:"rpcListenEndPoint"`
+	RpcListenEndPoint  string          `json:"rpcListenEndPoint"`
+	GrpcListenEndPoint string          `json:"grpcListenEndPoint"`
+	TlsCertFile        string          `json:"tlsCertFile"`
+	TlsKeyFile         string          `json:"tlsKeyFile"`
+	Auth               AuthConfig      `json:"auth"`
+	RateLimit          RateLimitConfig `json:"rateLimit"`
+}
+
+type BroadcastConfig struct {
+	MinFeeRate float64 `json:"minFeeRate"`
 }
 
 type Config struct {
@@ -44,6 +80,7 @@ type Config struct {
 	GatherConfig    GatherConfig    `json:"gatherConfig"`
 	RpcClientConfig RpcClientConfig `json:"rpcClientConfig"`
 	RpcServerConfig RpcServerConfig `json:"rpcServerConfig"`
+	BroadcastConfig BroadcastConfig `json:"broadcastConfig"`
 }
 
 type JsonStruct struct {
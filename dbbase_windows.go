@@ -3,7 +3,11 @@ package main
 import (
 	"errors"
 	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/syndtr/goleveldb/leveldb/util"
+	"os"
+	"path/filepath"
+	"time"
 )
 
 const (
@@ -13,13 +17,25 @@ const (
 var NotFoundError string
 
 type DBCommon struct {
-	ldb *leveldb.DB
+	// name of the db directory, the label of the latency metrics
+	name string
+	// the db directory is missing in the read only mode, the db reads as empty
+	missing bool
+	ldb     *leveldb.DB
 }
 
 func (d *DBCommon) DBOpen(dbFile string) error {
 	var err error
+	d.name = filepath.Base(dbFile)
+	if isDBReadOnly {
+		_, err = os.Stat(dbFile)
+		if os.IsNotExist(err) {
+			d.missing = true
+			return nil
+		}
+	}
 	if config.DBConfig.DbType == "leveldb" {
-		d.ldb, err = leveldb.OpenFile(dbFile, nil)
+		d.ldb, err = leveldb.OpenFile(dbFile, &opt.Options{ReadOnly: isDBReadOnly})
 		if err != nil {
 			return err
 		}
@@ -29,6 +45,9 @@ func (d *DBCommon) DBOpen(dbFile string) error {
 }
 
 func (d *DBCommon) DBClose() error {
+	if d.missing {
+		return nil
+	}
 	if config.DBConfig.DbType == "leveldb" {
 		err := d.ldb.Close()
 		if err != nil {
@@ -40,6 +59,10 @@ func (d *DBCommon) DBClose() error {
 }
 
 func (d DBCommon) DBPut(key []byte, value []byte) error {
+	if d.missing {
+		return errors.New("db is missing")
+	}
+	defer metrics.ObserveDB(d.name, "write", time.Now())
 	if config.DBConfig.DbType == "leveldb" {
 		err := d.ldb.Put(key, value, nil)
 		if err != nil {
@@ -51,6 +74,10 @@ func (d DBCommon) DBPut(key []byte, value []byte) error {
 }
 
 func (d DBCommon) DBGet(key []byte) ([]byte, error) {
+	if d.missing {
+		return nil, errors.New(NotFoundError)
+	}
+	defer metrics.ObserveDB(d.name, "read", time.Now())
 	if config.DBConfig.DbType == "leveldb" {
 		value, err := d.ldb.Get(key, nil)
 		if err != nil {
@@ -64,6 +91,10 @@ func (d DBCommon) DBGet(key []byte) ([]byte, error) {
 }
 
 func (d DBCommon) DBGetPrefix(key []byte) ([][]byte, error) {
+	if d.missing {
+		return nil, nil
+	}
+	defer metrics.ObserveDB(d.name, "read", time.Now())
 	var valuesBytes [][]byte
 	if config.DBConfig.DbType == "leveldb" {
 		iter := d.ldb.NewIterator(util.BytesPrefix(key), nil)
@@ -83,6 +114,10 @@ func (d DBCommon) DBGetPrefix(key []byte) ([][]byte, error) {
 }
 
 func (d DBCommon) DBDelete(key []byte) error {
+	if d.missing {
+		return errors.New("db is missing")
+	}
+	defer metrics.ObserveDB(d.name, "write", time.Now())
 	if config.DBConfig.DbType == "leveldb" {
 		err := d.ldb.Delete(key, nil)
 		if err != nil {
@@ -92,3 +127,92 @@ func (d DBCommon) DBDelete(key []byte) error {
 	}
 	return errors.New("invalid db type")
 }
+
+func (d DBCommon) DBIterate(key []byte, fn func(k []byte, v []byte) error) error {
+	if d.missing {
+		return nil
+	}
+	// the callbacks are not timed, they may query the dbs themselves
+	startTime := time.Now()
+	var fnDuration time.Duration
+	defer func() {
+		metrics.ObserveDB(d.name, "read", startTime.Add(fnDuration))
+	}()
+	if config.DBConfig.DbType == "leveldb" {
+		iter := d.ldb.NewIterator(util.BytesPrefix(key), nil)
+		for iter.Next() {
+			keyBytes := make([]byte, len(iter.Key()))
+			copy(keyBytes[0:], iter.Key())
+			valueBytes := make([]byte, len(iter.Value()))
+			copy(valueBytes[0:], iter.Value())
+			fnStartTime := time.Now()
+			err := fn(keyBytes, valueBytes)
+			fnDuration += time.Since(fnStartTime)
+			if err != nil {
+				iter.Release()
+				return err
+			}
+		}
+		iter.Release()
+		err := iter.Error()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+	return errors.New("invalid db type")
+}
+
+type DBBackupTask struct {
+	dstDir string
+	lsnap  *leveldb.Snapshot
+}
+
+func (d DBCommon) DBBackupBegin(dstDir string) (*DBBackupTask, error) {
+	if d.missing {
+		return nil, errors.New("db is missing")
+	}
+	if config.DBConfig.DbType == "leveldb" {
+		lsnap, err := d.ldb.GetSnapshot()
+		if err != nil {
+			return nil, err
+		}
+		return &DBBackupTask{dstDir: dstDir, lsnap: lsnap}, nil
+	}
+	return nil, errors.New("invalid db type")
+}
+
+func (t *DBBackupTask) Run() error {
+	if t.lsnap == nil {
+		return nil
+	}
+	defer t.lsnap.Release()
+	dstDB, err := leveldb.OpenFile(t.dstDir, nil)
+	if err != nil {
+		return err
+	}
+	iter := t.lsnap.NewIterator(nil, nil)
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		batch.Put(iter.Key(), iter.Value())
+		if batch.Len() >= 10000 {
+			err = dstDB.Write(batch, nil)
+			if err != nil {
+				break
+			}
+			batch.Reset()
+		}
+	}
+	iter.Release()
+	if err == nil {
+		err = iter.Error()
+	}
+	if err == nil {
+		err = dstDB.Write(batch, nil)
+	}
+	errClose := dstDB.Close()
+	if err != nil {
+		return err
+	}
+	return errClose
+}
@@ -24,6 +24,30 @@ type RawTrxDBMgr struct {
 	db *DBCommon
 }
 
+type TrxUndoDBMgr struct {
+	db *DBCommon
+}
+
+type TrxLocDBMgr struct {
+	db *DBCommon
+}
+
+type BlockDBMgr struct {
+	db *DBCommon
+}
+
+type BlockHashDBMgr struct {
+	db *DBCommon
+}
+
+type UtxoLockDBMgr struct {
+	db *DBCommon
+}
+
+type ScriptHashDBMgr struct {
+	db *DBCommon
+}
+
 func (g *GlobalConfigDBMgr) DBOpen(dbFile string) error {
 	g.db = new(DBCommon)
 	err := g.db.DBOpen(dbFile)
@@ -122,6 +146,16 @@ func (a AddrTrxsDBMgr) DBGetPrefix(key string) ([]uint32, error) {
 	return trxSeqsAll, nil
 }
 
+func (a AddrTrxsDBMgr) DBIterate(fn func(k string, v []uint32) error) error {
+	return a.db.DBIterate([]byte{}, func(keyBytes []byte, valueBytes []byte) error {
+		trxSeqs, err := trxSeqsFromBytes(valueBytes)
+		if err != nil {
+			return err
+		}
+		return fn(string(keyBytes), trxSeqs)
+	})
+}
+
 func (a AddrTrxsDBMgr) DBDelete(key string) error {
 	err := a.db.DBDelete([]byte(key))
 	if err != nil {
@@ -176,6 +210,38 @@ func (u UtxoDBMgr) DBGet(key UtxoSource) (UtxoDetail, error) {
 	return utxoDetail, nil
 }
 
+func (u UtxoDBMgr) DBIterate(fn func(k UtxoSource, v UtxoDetail) error) error {
+	return u.db.DBIterate([]byte{}, func(keyBytes []byte, valueBytes []byte) error {
+		utxoSrc, err := utxoSrcFromBytes(keyBytes)
+		if err != nil {
+			return err
+		}
+		utxoDetail, err := utxoDetailFromBytes(valueBytes)
+		if err != nil {
+			return err
+		}
+		return fn(utxoSrc, utxoDetail)
+	})
+}
+
+func (u UtxoDBMgr) DBIterateTrxId(trxId bigint.Uint256, fn func(k UtxoSource, v UtxoDetail) error) error {
+	keyBytes, err := uint256ToBytes(trxId)
+	if err != nil {
+		return err
+	}
+	return u.db.DBIterate(keyBytes, func(keyBytes []byte, valueBytes []byte) error {
+		utxoSrc, err := utxoSrcFromBytes(keyBytes)
+		if err != nil {
+			return err
+		}
+		utxoDetail, err := utxoDetailFromBytes(valueBytes)
+		if err != nil {
+			return err
+		}
+		return fn(utxoSrc, utxoDetail)
+	})
+}
+
 func (u UtxoDBMgr) DBDelete(key UtxoSource) error {
 	keyBytes, err := utxoSrcToBytes(key)
 	if err != nil {
@@ -237,6 +303,20 @@ func (t TrxSeqDBMgr) DBGet(key uint32) (bigint.Uint256, error) {
 	return ui256, nil
 }
 
+func (t TrxSeqDBMgr) DBIterate(fn func(k uint32, v bigint.Uint256) error) error {
+	return t.db.DBIterate([]byte{}, func(keyBytes []byte, valueBytes []byte) error {
+		trxSeq, err := uint32FromBytes(keyBytes)
+		if err != nil {
+			return err
+		}
+		trxId, err := uint256FromBytes(valueBytes)
+		if err != nil {
+			return err
+		}
+		return fn(trxSeq, trxId)
+	})
+}
+
 func (t TrxSeqDBMgr) DBDelete(key uint32) error {
 	keyBytes, err := uint32ToBytes(key)
 	if err != nil {
@@ -301,3 +381,355 @@ func (r RawTrxDBMgr) DBDelete(key bigint.Uint256) error {
 	}
 	return nil
 }
+
+func (t *TrxUndoDBMgr) DBOpen(dbFile string) error {
+	t.db = new(DBCommon)
+	err := t.db.DBOpen(dbFile)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *TrxUndoDBMgr) DBClose() error {
+	err := t.db.DBClose()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t TrxUndoDBMgr) DBPut(key bigint.Uint256, value []SpentUtxo) error {
+	keyBytes, err := uint256ToBytes(key)
+	if err != nil {
+		return err
+	}
+	valueBytes, err := spentUtxosToBytes(value)
+	if err != nil {
+		return err
+	}
+	err = t.db.DBPut(keyBytes, valueBytes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t TrxUndoDBMgr) DBGet(key bigint.Uint256) ([]SpentUtxo, error) {
+	keyBytes, err := uint256ToBytes(key)
+	if err != nil {
+		return nil, err
+	}
+	valueBytes, err := t.db.DBGet(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+	spentUtxos, err := spentUtxosFromBytes(valueBytes)
+	if err != nil {
+		return nil, err
+	}
+	return spentUtxos, nil
+}
+
+func (t TrxUndoDBMgr) DBDelete(key bigint.Uint256) error {
+	keyBytes, err := uint256ToBytes(key)
+	if err != nil {
+		return err
+	}
+	err = t.db.DBDelete(keyBytes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *TrxLocDBMgr) DBOpen(dbFile string) error {
+	t.db = new(DBCommon)
+	err := t.db.DBOpen(dbFile)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *TrxLocDBMgr) DBClose() error {
+	err := t.db.DBClose()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t TrxLocDBMgr) DBPut(key bigint.Uint256, value TrxLocation) error {
+	keyBytes, err := uint256ToBytes(key)
+	if err != nil {
+		return err
+	}
+	valueBytes, err := trxLocationToBytes(value)
+	if err != nil {
+		return err
+	}
+	err = t.db.DBPut(keyBytes, valueBytes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t TrxLocDBMgr) DBGet(key bigint.Uint256) (TrxLocation, error) {
+	keyBytes, err := uint256ToBytes(key)
+	if err != nil {
+		return TrxLocation{}, err
+	}
+	valueBytes, err := t.db.DBGet(keyBytes)
+	if err != nil {
+		return TrxLocation{}, err
+	}
+	trxLocation, err := trxLocationFromBytes(valueBytes)
+	if err != nil {
+		return TrxLocation{}, err
+	}
+	return trxLocation, nil
+}
+
+func (t TrxLocDBMgr) DBDelete(key bigint.Uint256) error {
+	keyBytes, err := uint256ToBytes(key)
+	if err != nil {
+		return err
+	}
+	err = t.db.DBDelete(keyBytes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (b *BlockDBMgr) DBOpen(dbFile string) error {
+	b.db = new(DBCommon)
+	err := b.db.DBOpen(dbFile)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (b *BlockDBMgr) DBClose() error {
+	err := b.db.DBClose()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (b BlockDBMgr) DBPut(key uint32, value BlockInfo) error {
+	keyBytes, err := uint32ToBytes(key)
+	if err != nil {
+		return err
+	}
+	valueBytes, err := blockInfoToBytes(value)
+	if err != nil {
+		return err
+	}
+	err = b.db.DBPut(keyBytes, valueBytes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (b BlockDBMgr) DBGet(key uint32) (BlockInfo, error) {
+	keyBytes, err := uint32ToBytes(key)
+	if err != nil {
+		return BlockInfo{}, err
+	}
+	valueBytes, err := b.db.DBGet(keyBytes)
+	if err != nil {
+		return BlockInfo{}, err
+	}
+	blockInfo, err := blockInfoFromBytes(valueBytes)
+	if err != nil {
+		return BlockInfo{}, err
+	}
+	return blockInfo, nil
+}
+
+func (b BlockDBMgr) DBDelete(key uint32) error {
+	keyBytes, err := uint32ToBytes(key)
+	if err != nil {
+		return err
+	}
+	err = b.db.DBDelete(keyBytes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (b *BlockHashDBMgr) DBOpen(dbFile string) error {
+	b.db = new(DBCommon)
+	err := b.db.DBOpen(dbFile)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (b *BlockHashDBMgr) DBClose() error {
+	err := b.db.DBClose()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (b BlockHashDBMgr) DBPut(key bigint.Uint256, value uint32) error {
+	keyBytes, err := uint256ToBytes(key)
+	if err != nil {
+		return err
+	}
+	valueBytes, err := uint32ToBytes(value)
+	if err != nil {
+		return err
+	}
+	err = b.db.DBPut(keyBytes, valueBytes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (b BlockHashDBMgr) DBGet(key bigint.Uint256) (uint32, error) {
+	keyBytes, err := uint256ToBytes(key)
+	if err != nil {
+		return 0, err
+	}
+	valueBytes, err := b.db.DBGet(keyBytes)
+	if err != nil {
+		return 0, err
+	}
+	ui32, err := uint32FromBytes(valueBytes)
+	if err != nil {
+		return 0, err
+	}
+	return ui32, nil
+}
+
+func (b BlockHashDBMgr) DBDelete(key bigint.Uint256) error {
+	keyBytes, err := uint256ToBytes(key)
+	if err != nil {
+		return err
+	}
+	err = b.db.DBDelete(keyBytes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *UtxoLockDBMgr) DBOpen(dbFile string) error {
+	u.db = new(DBCommon)
+	err := u.db.DBOpen(dbFile)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *UtxoLockDBMgr) DBClose() error {
+	err := u.db.DBClose()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u UtxoLockDBMgr) DBPut(key UtxoSource, value UtxoLock) error {
+	keyBytes, err := utxoSrcToBytes(key)
+	if err != nil {
+		return err
+	}
+	valueBytes, err := utxoLockToBytes(value)
+	if err != nil {
+		return err
+	}
+	err = u.db.DBPut(keyBytes, valueBytes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u UtxoLockDBMgr) DBGet(key UtxoSource) (UtxoLock, error) {
+	keyBytes, err := utxoSrcToBytes(key)
+	if err != nil {
+		return UtxoLock{}, err
+	}
+	valueBytes, err := u.db.DBGet(keyBytes)
+	if err != nil {
+		return UtxoLock{}, err
+	}
+	utxoLock, err := utxoLockFromBytes(valueBytes)
+	if err != nil {
+		return UtxoLock{}, err
+	}
+	return utxoLock, nil
+}
+
+func (u UtxoLockDBMgr) DBIterate(fn func(k UtxoSource, v UtxoLock) error) error {
+	return u.db.DBIterate([]byte{}, func(keyBytes []byte, valueBytes []byte) error {
+		utxoSrc, err := utxoSrcFromBytes(keyBytes)
+		if err != nil {
+			return err
+		}
+		utxoLock, err := utxoLockFromBytes(valueBytes)
+		if err != nil {
+			return err
+		}
+		return fn(utxoSrc, utxoLock)
+	})
+}
+
+func (u UtxoLockDBMgr) DBDelete(key UtxoSource) error {
+	keyBytes, err := utxoSrcToBytes(key)
+	if err != nil {
+		return err
+	}
+	err = u.db.DBDelete(keyBytes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *ScriptHashDBMgr) DBOpen(dbFile string) error {
+	s.db = new(DBCommon)
+	err := s.db.DBOpen(dbFile)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *ScriptHashDBMgr) DBClose() error {
+	err := s.db.DBClose()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s ScriptHashDBMgr) DBPut(key []byte, value string) error {
+	err := s.db.DBPut(key, []byte(value))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s ScriptHashDBMgr) DBGet(key []byte) (string, error) {
+	valueBytes, err := s.db.DBGet(key)
+	if err != nil {
+		return "", err
+	}
+	return string(valueBytes), nil
+}
@@ -0,0 +1,485 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/keyid"
+	"github.com/mutalisk999/bitcoin-lib/src/utility"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	DescriptorRangeEndDefault = 999
+	DescriptorRangeSizeMax    = 2000
+	descriptorInputCharset    = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	descriptorChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+type DescriptorScanArgs struct {
+	Descriptor string
+	RangeStart uint32
+	RangeEnd   uint32
+}
+
+type DescriptorAddressPrintAble struct {
+	Index     uint32
+	Address   string
+	TrxCount  uint32
+	Balance   int64
+	UtxoCount uint32
+}
+
+type DescriptorScanPrintAble struct {
+	Descriptor string
+	IsRange    bool
+	RangeStart uint32
+	RangeEnd   uint32
+	Balance    int64
+	UtxoCount  uint32
+	Addresses  []DescriptorAddressPrintAble
+	Trxs       []TrxStatusPrintAble
+	Utxos      []UtxoPrintAble
+}
+
+// descriptorKey is a key expression: a hex public key or an extended public key with a derivation path,
+// parentKey is derived by the path once, the range index is the only child derived per address
+type descriptorKey struct {
+	pubKey    []byte
+	extPubKey *ExtendedPubKey
+	parentKey *ExtendedPubKey
+	path      []uint32
+	isRange   bool
+}
+
+type descriptor struct {
+	name      string
+	threshold int
+	keys      []*descriptorKey
+	sub       *descriptor
+}
+
+func descriptorPolyMod(c uint64, val int) uint64 {
+	c0 := c >> 35
+	c = ((c & 0x7ffffffff) << 5) ^ uint64(val)
+	if c0&1 != 0 {
+		c ^= 0xf5dee51989
+	}
+	if c0&2 != 0 {
+		c ^= 0xa9fdca3312
+	}
+	if c0&4 != 0 {
+		c ^= 0x1bab10e32d
+	}
+	if c0&8 != 0 {
+		c ^= 0x3706b1677a
+	}
+	if c0&16 != 0 {
+		c ^= 0x644d626ffd
+	}
+	return c
+}
+
+// calcDescriptorChecksum computes the 8 characters checksum of the descriptor (BIP380)
+func calcDescriptorChecksum(desc string) (string, error) {
+	c := uint64(1)
+	cls := 0
+	clsCount := 0
+	for _, ch := range desc {
+		pos := strings.IndexRune(descriptorInputCharset, ch)
+		if pos < 0 {
+			return "", errors.New("invalid character in descriptor")
+		}
+		c = descriptorPolyMod(c, pos&31)
+		cls = cls*3 + (pos >> 5)
+		clsCount += 1
+		if clsCount == 3 {
+			c = descriptorPolyMod(c, cls)
+			cls = 0
+			clsCount = 0
+		}
+	}
+	if clsCount > 0 {
+		c = descriptorPolyMod(c, cls)
+	}
+	for i := 0; i < 8; i++ {
+		c = descriptorPolyMod(c, 0)
+	}
+	c ^= 1
+	checksum := make([]byte, 8)
+	for i := 0; i < 8; i++ {
+		checksum[i] = descriptorChecksumCharset[(c>>(5*(7-uint(i))))&31]
+	}
+	return string(checksum), nil
+}
+
+func parseDescriptorKey(keyStr string, isXOnlyAllowed bool) (*descriptorKey, error) {
+	// the key origin info is not needed to find the scripts
+	if strings.HasPrefix(keyStr, "[") {
+		pos := strings.Index(keyStr, "]")
+		if pos < 0 {
+			return nil, errors.New("invalid key origin: " + keyStr)
+		}
+		keyStr = keyStr[pos+1:]
+	}
+	key := new(descriptorKey)
+	items := strings.Split(keyStr, "/")
+	if len(items) == 1 {
+		pubKey, err := hex.DecodeString(keyStr)
+		if err != nil {
+			return nil, errors.New("invalid key: " + keyStr)
+		}
+		if len(pubKey) == 32 && isXOnlyAllowed {
+			key.pubKey = append([]byte{0x02}, pubKey...)
+		} else if len(pubKey) == 33 {
+			key.pubKey = pubKey
+		} else {
+			return nil, errors.New("invalid public key size: " + keyStr)
+		}
+		_, err = ecPointFromCompressed(key.pubKey)
+		if err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+
+	var err error
+	key.extPubKey, err = parseExtendedPubKey(items[0])
+	if err != nil {
+		return nil, err
+	}
+	for i, item := range items[1:] {
+		if item == "*" && i == len(items)-2 {
+			key.isRange = true
+			break
+		}
+		if strings.HasSuffix(item, "'") || strings.HasSuffix(item, "h") || item == "*'" || item == "*h" {
+			return nil, errors.New("can not derive hardened path from public key: " + keyStr)
+		}
+		ui64, err := strconv.ParseUint(item, 10, 32)
+		if err != nil || ui64 >= HardenedKeyStart {
+			return nil, errors.New("invalid derivation path: " + keyStr)
+		}
+		key.path = append(key.path, uint32(ui64))
+	}
+	key.parentKey = key.extPubKey
+	for _, childIndex := range key.path {
+		key.parentKey, err = key.parentKey.Child(childIndex)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+func (k *descriptorKey) derive(index uint32) ([]byte, error) {
+	if k.extPubKey == nil {
+		return k.pubKey, nil
+	}
+	if !k.isRange {
+		return k.parentKey.PubKey[0:], nil
+	}
+	extPubKey, err := k.parentKey.Child(index)
+	if err != nil {
+		return nil, err
+	}
+	return extPubKey.PubKey[0:], nil
+}
+
+// splitDescriptorArgs splits the arguments at the top level commas
+func splitDescriptorArgs(argsStr string) []string {
+	var args []string
+	depth := 0
+	start := 0
+	for i, ch := range argsStr {
+		if ch == '(' || ch == '[' || ch == '{' {
+			depth += 1
+		} else if ch == ')' || ch == ']' || ch == '}' {
+			depth -= 1
+		} else if ch == ',' && depth == 0 {
+			args = append(args, argsStr[start:i])
+			start = i + 1
+		}
+	}
+	return append(args, argsStr[start:])
+}
+
+func parseDescriptorExpr(expr string, parent string) (*descriptor, error) {
+	pos := strings.Index(expr, "(")
+	if pos <= 0 || !strings.HasSuffix(expr, ")") {
+		return nil, errors.New("invalid descriptor expression: " + expr)
+	}
+	desc := new(descriptor)
+	desc.name = expr[0:pos]
+	args := splitDescriptorArgs(expr[pos+1 : len(expr)-1])
+
+	switch desc.name {
+	case "pkh", "wpkh", "tr":
+		if len(args) != 1 {
+			if desc.name == "tr" {
+				return nil, errors.New("tr with script tree is not supported")
+			}
+			return nil, errors.New(desc.name + " expects one key")
+		}
+		if desc.name == "tr" && parent != "" {
+			return nil, errors.New("tr can only be at the top level")
+		}
+		if desc.name == "wpkh" && parent != "" && parent != "sh" {
+			return nil, errors.New("wpkh can only be at the top level or inside sh")
+		}
+		if desc.name == "pkh" && parent != "" {
+			return nil, errors.New("pkh can only be at the top level")
+		}
+		key, err := parseDescriptorKey(args[0], desc.name == "tr")
+		if err != nil {
+			return nil, err
+		}
+		desc.keys = []*descriptorKey{key}
+	case "multi", "sortedmulti":
+		if parent != "sh" && parent != "wsh" {
+			return nil, errors.New(desc.name + " can only be inside sh or wsh")
+		}
+		if len(args) < 2 || len(args) > 17 {
+			return nil, errors.New("invalid number of multisig keys")
+		}
+		threshold, err := strconv.Atoi(args[0])
+		if err != nil || threshold < 1 || threshold > len(args)-1 {
+			return nil, errors.New("invalid multisig threshold")
+		}
+		desc.threshold = threshold
+		for _, keyStr := range args[1:] {
+			key, err := parseDescriptorKey(keyStr, false)
+			if err != nil {
+				return nil, err
+			}
+			desc.keys = append(desc.keys, key)
+		}
+	case "sh", "wsh":
+		if len(args) != 1 {
+			return nil, errors.New(desc.name + " expects one script")
+		}
+		if desc.name == "sh" && parent != "" {
+			return nil, errors.New("sh can only be at the top level")
+		}
+		if desc.name == "wsh" && parent != "" && parent != "sh" {
+			return nil, errors.New("wsh can only be at the top level or inside sh")
+		}
+		sub, err := parseDescriptorExpr(args[0], desc.name)
+		if err != nil {
+			return nil, err
+		}
+		desc.sub = sub
+	default:
+		return nil, errors.New("not support descriptor: " + desc.name)
+	}
+	return desc, nil
+}
+
+// checkDescriptorChecksum verifies the checksum if present, returns the descriptor without and with the checksum
+func checkDescriptorChecksum(descStr string) (string, string, error) {
+	descStr = strings.TrimSpace(descStr)
+	isChecksumPresent := false
+	checksum := ""
+	pos := strings.Index(descStr, "#")
+	if pos >= 0 {
+		isChecksumPresent = true
+		checksum = descStr[pos+1:]
+		descStr = descStr[0:pos]
+	}
+	checksumCalc, err := calcDescriptorChecksum(descStr)
+	if err != nil {
+		return "", "", err
+	}
+	if isChecksumPresent && len(checksum) != 8 {
+		return "", "", errors.New("descriptor checksum should be 8 characters")
+	}
+	if isChecksumPresent && checksum != checksumCalc {
+		return "", "", errors.New("descriptor checksum mismatch, expect: " + checksumCalc)
+	}
+	return descStr, descStr + "#" + checksumCalc, nil
+}
+
+// parseDescriptor verifies the checksum if present, returns the descriptor with its checksum
+func parseDescriptor(descStr string) (*descriptor, string, error) {
+	descStr, descStrChecksum, err := checkDescriptorChecksum(descStr)
+	if err != nil {
+		return nil, "", err
+	}
+	desc, err := parseDescriptorExpr(descStr, "")
+	if err != nil {
+		return nil, "", err
+	}
+	return desc, descStrChecksum, nil
+}
+
+func (d *descriptor) isRange() bool {
+	for _, key := range d.keys {
+		if key.isRange {
+			return true
+		}
+	}
+	if d.sub != nil {
+		return d.sub.isRange()
+	}
+	return false
+}
+
+func (d *descriptor) multisigScript(index uint32) ([]byte, error) {
+	var pubKeys [][]byte
+	for _, key := range d.keys {
+		pubKey, err := key.derive(index)
+		if err != nil {
+			return nil, err
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	if d.name == "sortedmulti" {
+		sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i], pubKeys[j]) < 0 })
+	}
+	// OP_k <pubkeys> OP_n OP_CHECKMULTISIG
+	scriptBytes := []byte{byte(0x50 + d.threshold)}
+	for _, pubKey := range pubKeys {
+		scriptBytes = append(scriptBytes, byte(len(pubKey)))
+		scriptBytes = append(scriptBytes, pubKey...)
+	}
+	scriptBytes = append(scriptBytes, byte(0x50+len(pubKeys)), 0xae)
+	return scriptBytes, nil
+}
+
+func scriptHashAddress(scriptBytes []byte) (string, error) {
+	var scriptId keyid.KeyID
+	err := scriptId.SetKeyIDData(utility.Hash160(scriptBytes))
+	if err != nil {
+		return "", err
+	}
+	return scriptId.ToBase58Address(networkParams.ScriptHashVersion)
+}
+
+// address derives the address of the descriptor at the index, as it is indexed in addr_trx_db
+func (d *descriptor) address(index uint32) (string, error) {
+	switch d.name {
+	case "pkh", "wpkh":
+		pubKey, err := d.keys[0].derive(index)
+		if err != nil {
+			return "", err
+		}
+		if d.name == "pkh" {
+			return getPubKeyAddress(pubKey, XpubScriptTypeP2PKH)
+		}
+		return getPubKeyAddress(pubKey, XpubScriptTypeP2WPKH)
+	case "tr":
+		pubKey, err := d.keys[0].derive(index)
+		if err != nil {
+			return "", err
+		}
+		return getPubKeyAddress(pubKey, XpubScriptTypeP2TR)
+	case "wsh":
+		witnessScript, err := d.sub.multisigScript(index)
+		if err != nil {
+			return "", err
+		}
+		return encodeSegWitAddress(networkParams.SegWitHrp, 0, utility.Sha256(witnessScript))
+	case "sh":
+		var redeemScript []byte
+		if d.sub.name == "wpkh" {
+			pubKey, err := d.sub.keys[0].derive(index)
+			if err != nil {
+				return "", err
+			}
+			return getPubKeyAddress(pubKey, XpubScriptTypeP2SHWPK)
+		} else if d.sub.name == "wsh" {
+			witnessScript, err := d.sub.sub.multisigScript(index)
+			if err != nil {
+				return "", err
+			}
+			redeemScript = append([]byte{0x00, 0x20}, utility.Sha256(witnessScript)...)
+		} else {
+			var err error
+			redeemScript, err = d.sub.multisigScript(index)
+			if err != nil {
+				return "", err
+			}
+		}
+		if len(redeemScript) > 520 {
+			return "", errors.New("redeem script is too large")
+		}
+		return scriptHashAddress(redeemScript)
+	}
+	return "", errors.New("not support descriptor: " + d.name)
+}
+
+func scanDescriptor(args *DescriptorScanArgs) (DescriptorScanPrintAble, error) {
+	desc, descStr, err := parseDescriptor(args.Descriptor)
+	if err != nil {
+		return DescriptorScanPrintAble{}, err
+	}
+	var descScan DescriptorScanPrintAble
+	descScan.Descriptor = descStr
+	descScan.IsRange = desc.isRange()
+	if descScan.IsRange {
+		descScan.RangeStart = args.RangeStart
+		descScan.RangeEnd = args.RangeEnd
+		if descScan.RangeEnd == 0 {
+			descScan.RangeEnd = DescriptorRangeEndDefault
+		}
+		if descScan.RangeEnd < descScan.RangeStart || descScan.RangeEnd >= HardenedKeyStart {
+			return DescriptorScanPrintAble{}, errors.New("invalid range")
+		}
+		if descScan.RangeEnd-descScan.RangeStart >= DescriptorRangeSizeMax {
+			return DescriptorScanPrintAble{}, errors.New("range is too large, max: " + strconv.Itoa(DescriptorRangeSizeMax))
+		}
+	}
+
+	// expand the descriptor to addresses and find the used ones
+	descAddresses := make([]DescriptorAddressPrintAble, descScan.RangeEnd-descScan.RangeStart+1)
+	err = queryConcurrently(len(descAddresses), func(i int) error {
+		index := descScan.RangeStart + uint32(i)
+		descAddresses[i].Index = index
+		addrStr, err := desc.address(index)
+		if err != nil {
+			// the derived key is invalid, skip the index
+			return nil
+		}
+		descAddresses[i].Address = addrStr
+		trxSeqs, err := getAddressTrxSeqs(addrStr)
+		if err != nil {
+			return err
+		}
+		descAddresses[i].TrxCount = uint32(len(trxSeqs))
+		return nil
+	})
+	if err != nil {
+		return DescriptorScanPrintAble{}, err
+	}
+	var addresses []string
+	descScan.Addresses = []DescriptorAddressPrintAble{}
+	for _, descAddress := range descAddresses {
+		if descAddress.TrxCount != 0 {
+			addresses = append(addresses, descAddress.Address)
+			descScan.Addresses = append(descScan.Addresses, descAddress)
+		}
+	}
+
+	addressesTrxs, err := getAddressesTrxs(addresses)
+	if err != nil {
+		return DescriptorScanPrintAble{}, err
+	}
+	addressesUtxos, err := listUnSpentMulti(addresses)
+	if err != nil {
+		return DescriptorScanPrintAble{}, err
+	}
+	descScan.Trxs = addressesTrxs.Trxs
+	descScan.Utxos = []UtxoPrintAble{}
+	for i := range descScan.Addresses {
+		for _, utxo := range addressesUtxos[i].Utxos {
+			descScan.Addresses[i].Balance += utxo.Amount
+			descScan.Addresses[i].UtxoCount += 1
+		}
+		descScan.Balance += descScan.Addresses[i].Balance
+		descScan.UtxoCount += descScan.Addresses[i].UtxoCount
+		descScan.Utxos = append(descScan.Utxos, addressesUtxos[i].Utxos...)
+	}
+	return descScan, nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/hex"
+	"github.com/mutalisk999/bitcoin-lib/src/script"
+	"testing"
+)
+
+// the checksum test vectors of BIP380
+func TestDescriptorChecksum(t *testing.T) {
+	descStr, descStrChecksum, err := checkDescriptorChecksum("raw(deadbeef)#89f8spxm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if descStr != "raw(deadbeef)" || descStrChecksum != "raw(deadbeef)#89f8spxm" {
+		t.Fatalf("descriptor %s %s", descStr, descStrChecksum)
+	}
+	_, descStrChecksum, err = checkDescriptorChecksum("raw(deadbeef)")
+	if err != nil || descStrChecksum != "raw(deadbeef)#89f8spxm" {
+		t.Fatalf("descriptor without checksum %s %v", descStrChecksum, err)
+	}
+	for _, invalid := range []string{
+		"raw(deadbeef)#",
+		"raw(deadbeef)#89f8spxmx",
+		"raw(deadbeef)#89f8spx",
+		"raw(deedbeef)#89f8spxm",
+		"raw(deadbeef)##9f8spxm",
+		"raw(Ü)#00000000",
+	} {
+		_, _, err = checkDescriptorChecksum(invalid)
+		if err == nil {
+			t.Fatalf("invalid descriptor %s accepted", invalid)
+		}
+	}
+}
+
+// the address of the descriptor is the indexed address of the script, the xpub vector is of descriptor_tests.cpp
+// of bitcoin core, the other scripts are hashed from the keys
+func TestDescriptorAddress(t *testing.T) {
+	for _, vector := range []struct {
+		desc      string
+		scriptHex string
+	}{
+		{"wpkh(03a34b99f22c790c4e36b2b3c2c35a36db06226e41c692fc82b8b56ac1c540c5bd)",
+			"00149a1c78a507689f6f54b847ad1cef1e614ee23f1e"},
+		{"sh(wpkh(03a34b99f22c790c4e36b2b3c2c35a36db06226e41c692fc82b8b56ac1c540c5bd))",
+			"a91484ab21b1b2fd065d4504ff693d832434b6108d7b87"},
+		{"sh(multi(2,022f8bde4d1a07209355b4a7250a5c5128e88b84bddc619ab7cba8d569b240efe4,025cbdf0646e5db4eaa398f365f2ea7a0e3d419b7e0330e39ce92bddedcac4f9bc))",
+			"a91421ed952d4b024761e9b61cbedbff0a0fd231024587"},
+		{"pkh(xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw/1/2)",
+			"76a914f833c08f02389c451ae35ec797fccf7f396616bf88ac"},
+	} {
+		desc, _, err := parseDescriptor(vector.desc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		addrStr, err := desc.address(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scriptBytes, _ := hex.DecodeString(vector.scriptHex)
+		var scriptPubKey script.Script
+		scriptPubKey.SetScriptBytes(scriptBytes)
+		if addrStr != getAddressFromScript(scriptPubKey) {
+			t.Fatalf("address of %s is %s, expected %s", vector.desc, addrStr, getAddressFromScript(scriptPubKey))
+		}
+	}
+}
+
+// a range is derived from the parent key of the path, the same as deriving the full path
+func TestDescriptorRange(t *testing.T) {
+	xpub := "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"
+	desc, _, err := parseDescriptor("wpkh(" + xpub + "/1/*)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !desc.isRange() {
+		t.Fatal("descriptor is not a range")
+	}
+	for index := uint32(0); index < 3; index++ {
+		descIndex, _, err := parseDescriptor("wpkh(" + xpub + "/1/" + string(rune('0'+index)) + ")")
+		if err != nil {
+			t.Fatal(err)
+		}
+		addrStr, err := desc.address(index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		addrStrExpected, err := descIndex.address(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if addrStr != addrStrExpected {
+			t.Fatalf("address %d is %s, expected %s", index, addrStr, addrStrExpected)
+		}
+	}
+}
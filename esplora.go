@@ -0,0 +1,616 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"github.com/mutalisk999/bitcoin-lib/src/bigint"
+	"github.com/mutalisk999/bitcoin-lib/src/script"
+	"github.com/mutalisk999/bitcoin-lib/src/utility"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	EsploraPathPrefix     = "/api"
+	EsploraTrxsPageSize   = 25
+	EsploraPostTrxSizeMax = 4000000
+)
+
+// esplora fee estimate targets in blocks
+var esploraFeeTargets = []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 144, 504, 1008}
+
+type EsploraStatus struct {
+	Confirmed   bool   `json:"confirmed"`
+	BlockHeight uint32 `json:"block_height,omitempty"`
+	BlockHash   string `json:"block_hash,omitempty"`
+	BlockTime   uint32 `json:"block_time,omitempty"`
+}
+
+type EsploraTxOut struct {
+	ScriptPubKey        string `json:"scriptpubkey"`
+	ScriptPubKeyType    string `json:"scriptpubkey_type"`
+	ScriptPubKeyAddress string `json:"scriptpubkey_address,omitempty"`
+	Value               int64  `json:"value"`
+}
+
+type EsploraTxIn struct {
+	TrxId      string        `json:"txid"`
+	Vout       uint32        `json:"vout"`
+	PrevOut    *EsploraTxOut `json:"prevout"`
+	ScriptSig  string        `json:"scriptsig"`
+	Witness    []string      `json:"witness,omitempty"`
+	IsCoinBase bool          `json:"is_coinbase"`
+	Sequence   uint32        `json:"sequence"`
+}
+
+type EsploraTrx struct {
+	TrxId    string         `json:"txid"`
+	Version  int32          `json:"version"`
+	LockTime uint32         `json:"locktime"`
+	Vin      []EsploraTxIn  `json:"vin"`
+	Vout     []EsploraTxOut `json:"vout"`
+	Size     int            `json:"size"`
+	Weight   int            `json:"weight"`
+	Fee      int64          `json:"fee"`
+	Status   EsploraStatus  `json:"status"`
+}
+
+type EsploraTxoStats struct {
+	FundedTxoCount uint32 `json:"funded_txo_count"`
+	FundedTxoSum   int64  `json:"funded_txo_sum"`
+	SpentTxoCount  uint32 `json:"spent_txo_count"`
+	SpentTxoSum    int64  `json:"spent_txo_sum"`
+	TxCount        uint32 `json:"tx_count"`
+}
+
+type EsploraAddressStats struct {
+	Address      string          `json:"address,omitempty"`
+	ScriptHash   string          `json:"scripthash,omitempty"`
+	ChainStats   EsploraTxoStats `json:"chain_stats"`
+	MempoolStats EsploraTxoStats `json:"mempool_stats"`
+}
+
+type EsploraUtxo struct {
+	TrxId  string        `json:"txid"`
+	Vout   uint32        `json:"vout"`
+	Status EsploraStatus `json:"status"`
+	Value  int64         `json:"value"`
+}
+
+type EsploraMerkleProof struct {
+	BlockHeight uint32   `json:"block_height"`
+	Merkle      []string `json:"merkle"`
+	Pos         uint32   `json:"pos"`
+}
+
+func getEsploraScriptType(scriptBytes []byte) string {
+	scriptLen := len(scriptBytes)
+	if scriptLen == 25 && scriptBytes[0] == 0x76 && scriptBytes[1] == 0xa9 && scriptBytes[2] == 0x14 &&
+		scriptBytes[23] == 0x88 && scriptBytes[24] == 0xac {
+		return "p2pkh"
+	} else if scriptLen == 23 && scriptBytes[0] == 0xa9 && scriptBytes[1] == 0x14 && scriptBytes[22] == 0x87 {
+		return "p2sh"
+	} else if scriptLen == 22 && scriptBytes[0] == 0x00 && scriptBytes[1] == 0x14 {
+		return "v0_p2wpkh"
+	} else if scriptLen == 34 && scriptBytes[0] == 0x00 && scriptBytes[1] == 0x20 {
+		return "v0_p2wsh"
+	} else if scriptLen == 34 && scriptBytes[0] == 0x51 && scriptBytes[1] == 0x20 {
+		return "v1_p2tr"
+	} else if scriptLen > 0 && scriptBytes[0] == 0x6a {
+		return "op_return"
+	} else if (scriptLen == 35 && scriptBytes[0] == 33 || scriptLen == 67 && scriptBytes[0] == 65) && scriptBytes[scriptLen-1] == 0xac {
+		return "p2pk"
+	}
+	var scriptPubKey script.Script
+	scriptPubKey.SetScriptBytes(scriptBytes)
+	_, scriptType, _ := script.ExtractDestination(scriptPubKey)
+	if scriptType == script.TX_MULTISIG {
+		return "multisig"
+	}
+	return "unknown"
+}
+
+func getEsploraTxOut(scriptBytes []byte, addrStr string, value int64) EsploraTxOut {
+	var txOut EsploraTxOut
+	txOut.ScriptPubKey = hex.EncodeToString(scriptBytes)
+	txOut.ScriptPubKeyType = getEsploraScriptType(scriptBytes)
+	// bare multisig has no address
+	if !strings.Contains(addrStr, ",") {
+		txOut.ScriptPubKeyAddress = addrStr
+	}
+	txOut.Value = value
+	return txOut
+}
+
+func getEsploraStatus(blockHeight uint32, isConfirmed bool) EsploraStatus {
+	var status EsploraStatus
+	if !isConfirmed {
+		return status
+	}
+	status.Confirmed = true
+	status.BlockHeight = blockHeight
+	blockInfo, err := blockDBMgr.DBGet(blockHeight)
+	if err == nil {
+		status.BlockHash = blockInfo.BlockHash.GetHex()
+		status.BlockTime = blockInfo.Header.Time
+	}
+	return status
+}
+
+func getEsploraTrx(trxId bigint.Uint256) (EsploraTrx, error) {
+	trx, err := getTrxByTrxId(trxId)
+	if err != nil {
+		return EsploraTrx{}, newNotFoundError("transaction")
+	}
+	trxVerbose, err := getTrxVerbosePrintAble(trxId, trx)
+	if err != nil {
+		return EsploraTrx{}, err
+	}
+	var esploraTrx EsploraTrx
+	esploraTrx.TrxId = trxVerbose.TrxId
+	esploraTrx.Version = trxVerbose.Version
+	esploraTrx.LockTime = trxVerbose.LockTime
+	esploraTrx.Size = trxVerbose.Size
+	esploraTrx.Weight = trxVerbose.Weight
+	esploraTrx.Fee = trxVerbose.Fee
+	isCoinBase := isCoinBaseTrx(trx)
+	esploraTrx.Vin = make([]EsploraTxIn, 0, len(trx.Vin))
+	for i, vin := range trx.Vin {
+		var txIn EsploraTxIn
+		txIn.TrxId = vin.PrevOut.Hash.GetHex()
+		txIn.Vout = vin.PrevOut.N
+		txIn.ScriptSig = trxVerbose.Vin[i].ScriptSig
+		txIn.Witness = trxVerbose.Vin[i].ScriptWitness
+		txIn.IsCoinBase = isCoinBase
+		txIn.Sequence = vin.Sequence
+		if !isCoinBase {
+			scriptBytes, err := hex.DecodeString(trxVerbose.Vin[i].ScriptPubKey)
+			if err != nil {
+				return EsploraTrx{}, err
+			}
+			prevOut := getEsploraTxOut(scriptBytes, trxVerbose.Vin[i].Address, trxVerbose.Vin[i].Value)
+			txIn.PrevOut = &prevOut
+		}
+		esploraTrx.Vin = append(esploraTrx.Vin, txIn)
+	}
+	esploraTrx.Vout = make([]EsploraTxOut, 0, len(trx.Vout))
+	for _, vout := range trx.Vout {
+		esploraTrx.Vout = append(esploraTrx.Vout, getEsploraTxOut(vout.ScriptPubKey.GetScriptBytes(),
+			getAddressFromScript(vout.ScriptPubKey), vout.Value))
+	}
+	trxLocation, err := trxLocDBMgr.DBGet(trxId)
+	esploraTrx.Status = getEsploraStatus(trxLocation.BlockHeight, err == nil)
+	return esploraTrx, nil
+}
+
+// getEsploraAddressTrxIds returns the trx ids of the address from the newest
+func getEsploraAddressTrxIds(addrStr string) ([]bigint.Uint256, error) {
+	trxSeqs, err := getAddressTrxSeqs(addrStr)
+	if err != nil {
+		return nil, err
+	}
+	trxIds := make([]bigint.Uint256, 0, len(trxSeqs))
+	for i := len(trxSeqs) - 1; i >= 0; i-- {
+		trxId, err := trxSeqDBMgr.DBGet(trxSeqs[i])
+		if err != nil {
+			continue
+		}
+		trxIds = append(trxIds, trxId)
+	}
+	return trxIds, nil
+}
+
+func getEsploraAddressStats(addrStr string) (EsploraTxoStats, error) {
+	if addrStr == "" {
+		return EsploraTxoStats{}, nil
+	}
+	trxSeqs, err := getAddressTrxSeqs(addrStr)
+	if err != nil {
+		return EsploraTxoStats{}, err
+	}
+	return getAddressTxoStats(addrStr, trxSeqs)
+}
+
+// getAddressTxoStats sums the outputs of the address from the dbs without the raw trxs,
+// the spent outputs are in the trx undo of the history trxs which spend them, the unspent ones are in the utxo db
+func getAddressTxoStats(addrStr string, trxSeqs []uint32) (EsploraTxoStats, error) {
+	var stats EsploraTxoStats
+	stats.TxCount = uint32(len(trxSeqs))
+	for _, trxSeq := range trxSeqs {
+		trxId, err := trxSeqDBMgr.DBGet(trxSeq)
+		if err != nil {
+			continue
+		}
+		spentUtxos, err := trxUndoDBMgr.DBGet(trxId)
+		if err != nil {
+			if err.Error() != NotFoundError {
+				return EsploraTxoStats{}, err
+			}
+			// the coinbase spends nothing
+			trxLocation, err := trxLocDBMgr.DBGet(trxId)
+			if err == nil && trxLocation.BlockIndex == 0 {
+				continue
+			}
+			return EsploraTxoStats{}, errors.New("trx undo " + trxId.GetHex() + " is missing, the address stats are not available below the snapshot height")
+		}
+		for _, spentUtxo := range spentUtxos {
+			if spentUtxo.UtxoDetail.Address == addrStr {
+				stats.SpentTxoCount += 1
+				stats.SpentTxoSum += spentUtxo.UtxoDetail.Amount
+			}
+		}
+	}
+	utxos, err := getAddressUtxos(addrStr, trxSeqs)
+	if err != nil {
+		return EsploraTxoStats{}, err
+	}
+	stats.FundedTxoCount = stats.SpentTxoCount + uint32(len(utxos))
+	stats.FundedTxoSum = stats.SpentTxoSum
+	for _, utxo := range utxos {
+		stats.FundedTxoSum += utxo.Amount
+	}
+	return stats, nil
+}
+
+func calcMerkleBranch(hashes [][]byte, pos int) [][]byte {
+	level := make([][]byte, len(hashes))
+	copy(level, hashes)
+	var branch [][]byte
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+		branch = append(branch, level[pos^1])
+		next := make([][]byte, 0, len(level)/2)
+		for i := 0; i < len(level); i += 2 {
+			data := append(append([]byte{}, level[i]...), level[i+1]...)
+			next = append(next, utility.Sha256(utility.Sha256(data)))
+		}
+		level = next
+		pos /= 2
+	}
+	return branch
+}
+
+func getEsploraMerkleProof(trxId bigint.Uint256) (EsploraMerkleProof, error) {
+	trxLocation, err := trxLocDBMgr.DBGet(trxId)
+	if err != nil {
+		return EsploraMerkleProof{}, newNotFoundError("transaction")
+	}
+	blockInfo, err := blockDBMgr.DBGet(trxLocation.BlockHeight)
+	if err != nil {
+		return EsploraMerkleProof{}, newNotFoundError("block")
+	}
+	hashes := make([][]byte, 0, blockInfo.TrxCount)
+	for i := uint32(0); i < blockInfo.TrxCount; i++ {
+		blockTrxId, err := trxSeqDBMgr.DBGet(blockInfo.FirstTrxSeq + i)
+		if err != nil {
+			return EsploraMerkleProof{}, err
+		}
+		hashes = append(hashes, blockTrxId.GetData())
+	}
+	var merkleProof EsploraMerkleProof
+	merkleProof.BlockHeight = trxLocation.BlockHeight
+	merkleProof.Pos = trxLocation.BlockIndex
+	merkleProof.Merkle = []string{}
+	for _, hash := range calcMerkleBranch(hashes, int(trxLocation.BlockIndex)) {
+		var hashUint256 bigint.Uint256
+		err = hashUint256.SetData(hash)
+		if err != nil {
+			return EsploraMerkleProof{}, err
+		}
+		merkleProof.Merkle = append(merkleProof.Merkle, hashUint256.GetHex())
+	}
+	return merkleProof, nil
+}
+
+func writeEsploraJson(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeEsploraText(w http.ResponseWriter, status int, text string) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(text))
+}
+
+// writeEsploraError writes the error as plain text like esplora does
+func writeEsploraError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, errNotFound) {
+		status = http.StatusNotFound
+	} else if strings.HasPrefix(err.Error(), "invalid") {
+		status = http.StatusBadRequest
+	}
+	writeEsploraText(w, status, err.Error())
+}
+
+// getEsploraAddress resolves the address of the address or scripthash routes,
+// an unknown script hash has no history and resolves to empty address
+func getEsploraAddress(r *http.Request, stats *EsploraAddressStats) (string, error) {
+	vars := mux.Vars(r)
+	addrStr, ok := vars["addr"]
+	if ok {
+		_, err := getScriptFromAddress(addrStr)
+		if err != nil {
+			return "", errors.New("invalid bitcoin address")
+		}
+		stats.Address = addrStr
+		return addrStr, nil
+	}
+	stats.ScriptHash = strings.ToLower(vars["hash"])
+	addrStr, err := getAddressByScriptHash(stats.ScriptHash)
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return addrStr, nil
+}
+
+func getEsploraTrxId(r *http.Request) (bigint.Uint256, error) {
+	var trxId bigint.Uint256
+	trxIdStr := mux.Vars(r)["txid"]
+	if len(trxIdStr) != 64 || trxId.SetHex(trxIdStr) != nil {
+		return trxId, errors.New("invalid hex string")
+	}
+	return trxId, nil
+}
+
+func esploraGetAddress(w http.ResponseWriter, r *http.Request) {
+	var stats EsploraAddressStats
+	addrStr, err := getEsploraAddress(r, &stats)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	stats.ChainStats, err = getEsploraAddressStats(addrStr)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	writeEsploraJson(w, stats)
+}
+
+func esploraGetAddressTrxs(w http.ResponseWriter, r *http.Request) {
+	var stats EsploraAddressStats
+	addrStr, err := getEsploraAddress(r, &stats)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	trxs := []EsploraTrx{}
+	// no mempool, the mempool route is always empty
+	if addrStr == "" || strings.HasSuffix(r.URL.Path, "/mempool") {
+		writeEsploraJson(w, trxs)
+		return
+	}
+	trxIds, err := getEsploraAddressTrxIds(addrStr)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	lastSeenTrxIdStr, ok := mux.Vars(r)["last_seen_txid"]
+	if ok {
+		var lastSeenTrxId bigint.Uint256
+		if len(lastSeenTrxIdStr) != 64 || lastSeenTrxId.SetHex(lastSeenTrxIdStr) != nil {
+			writeEsploraError(w, errors.New("invalid hex string"))
+			return
+		}
+		start := len(trxIds)
+		for i, trxId := range trxIds {
+			if bytes.Equal(trxId.GetData(), lastSeenTrxId.GetData()) {
+				start = i + 1
+				break
+			}
+		}
+		trxIds = trxIds[start:]
+	}
+	if len(trxIds) > EsploraTrxsPageSize {
+		trxIds = trxIds[0:EsploraTrxsPageSize]
+	}
+	for _, trxId := range trxIds {
+		esploraTrx, err := getEsploraTrx(trxId)
+		if err != nil {
+			writeEsploraError(w, err)
+			return
+		}
+		trxs = append(trxs, esploraTrx)
+	}
+	writeEsploraJson(w, trxs)
+}
+
+func esploraGetAddressUtxos(w http.ResponseWriter, r *http.Request) {
+	var stats EsploraAddressStats
+	addrStr, err := getEsploraAddress(r, &stats)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	esploraUtxos := []EsploraUtxo{}
+	if addrStr == "" {
+		writeEsploraJson(w, esploraUtxos)
+		return
+	}
+	trxSeqs, err := getAddressTrxSeqs(addrStr)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	utxos, err := getAddressUtxos(addrStr, trxSeqs)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	for _, utxo := range utxos {
+		esploraUtxos = append(esploraUtxos, EsploraUtxo{utxo.TrxId, utxo.Vout, getEsploraStatus(utxo.BlockHeight, true), utxo.Amount})
+	}
+	writeEsploraJson(w, esploraUtxos)
+}
+
+func esploraGetTrx(w http.ResponseWriter, r *http.Request) {
+	trxId, err := getEsploraTrxId(r)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	esploraTrx, err := getEsploraTrx(trxId)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	writeEsploraJson(w, esploraTrx)
+}
+
+func esploraGetTrxStatus(w http.ResponseWriter, r *http.Request) {
+	trxId, err := getEsploraTrxId(r)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	trxLocation, err := trxLocDBMgr.DBGet(trxId)
+	if err != nil {
+		writeEsploraError(w, newNotFoundError("transaction"))
+		return
+	}
+	writeEsploraJson(w, getEsploraStatus(trxLocation.BlockHeight, true))
+}
+
+func esploraGetRawTrx(w http.ResponseWriter, r *http.Request) {
+	trxId, err := getEsploraTrxId(r)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	rawTrxBytes, err := getRawTrxBytes(trxId)
+	if err != nil {
+		writeEsploraError(w, newNotFoundError("transaction"))
+		return
+	}
+	if strings.HasSuffix(r.URL.Path, "/raw") {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(rawTrxBytes)
+		return
+	}
+	writeEsploraText(w, http.StatusOK, hex.EncodeToString(rawTrxBytes))
+}
+
+func esploraGetMerkleProof(w http.ResponseWriter, r *http.Request) {
+	trxId, err := getEsploraTrxId(r)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	merkleProof, err := getEsploraMerkleProof(trxId)
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	writeEsploraJson(w, merkleProof)
+}
+
+func esploraPostTrx(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, EsploraPostTrxSizeMax*2+1))
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	result := sendRawTrx(strings.TrimSpace(string(body)))
+	if !result.Accepted {
+		writeEsploraText(w, http.StatusBadRequest, "sendrawtransaction RPC error: "+result.RejectCode+": "+result.RejectReason)
+		return
+	}
+	writeEsploraText(w, http.StatusOK, result.TrxId)
+}
+
+func esploraGetTipHeight(w http.ResponseWriter, r *http.Request) {
+	writeEsploraText(w, http.StatusOK, strconv.Itoa(int(getFlushedBlockHeight())))
+}
+
+func esploraGetTipHash(w http.ResponseWriter, r *http.Request) {
+	blockInfo, err := blockDBMgr.DBGet(getFlushedBlockHeight())
+	if err != nil {
+		writeEsploraError(w, newNotFoundError("block"))
+		return
+	}
+	writeEsploraText(w, http.StatusOK, blockInfo.BlockHash.GetHex())
+}
+
+func esploraGetBlockHashByHeight(w http.ResponseWriter, r *http.Request) {
+	blockHeight, err := strconv.ParseUint(mux.Vars(r)["height"], 10, 32)
+	if err != nil {
+		writeEsploraError(w, errors.New("invalid block height"))
+		return
+	}
+	blockInfo, err := blockDBMgr.DBGet(uint32(blockHeight))
+	if err != nil {
+		writeEsploraError(w, newNotFoundError("block"))
+		return
+	}
+	writeEsploraText(w, http.StatusOK, blockInfo.BlockHash.GetHex())
+}
+
+func esploraGetBlockHeader(w http.ResponseWriter, r *http.Request) {
+	blockHashStr := mux.Vars(r)["hash"]
+	if len(blockHashStr) != 64 {
+		writeEsploraError(w, errors.New("invalid hex string"))
+		return
+	}
+	_, blockInfo, err := getBlockInfo(blockHashStr)
+	if err != nil {
+		writeEsploraError(w, newNotFoundError("block"))
+		return
+	}
+	bytesBuf := bytes.NewBuffer([]byte{})
+	err = blockInfo.Header.Pack(io.Writer(bytesBuf))
+	if err != nil {
+		writeEsploraError(w, err)
+		return
+	}
+	writeEsploraText(w, http.StatusOK, hex.EncodeToString(bytesBuf.Bytes()))
+}
+
+func esploraGetFeeEstimates(w http.ResponseWriter, r *http.Request) {
+	feeEstimates := make(map[string]float64)
+	for _, target := range esploraFeeTargets {
+		estimate, err := estimateFee(target)
+		if err != nil {
+			break
+		}
+		feeEstimates[strconv.Itoa(int(target))] = estimate.FeeRate
+	}
+	writeEsploraJson(w, feeEstimates)
+}
+
+func registerEsploraHandlers(urlRouter *mux.Router) {
+	esploraRouter := urlRouter.PathPrefix(EsploraPathPrefix).Subrouter()
+	for _, prefix := range []string{"/address/{addr}", "/scripthash/{hash:[0-9a-fA-F]{64}}"} {
+		esploraRouter.HandleFunc(prefix, esploraGetAddress).Methods("GET")
+		esploraRouter.HandleFunc(prefix+"/txs", esploraGetAddressTrxs).Methods("GET")
+		esploraRouter.HandleFunc(prefix+"/txs/chain", esploraGetAddressTrxs).Methods("GET")
+		esploraRouter.HandleFunc(prefix+"/txs/chain/{last_seen_txid}", esploraGetAddressTrxs).Methods("GET")
+		esploraRouter.HandleFunc(prefix+"/txs/mempool", esploraGetAddressTrxs).Methods("GET")
+		esploraRouter.HandleFunc(prefix+"/utxo", esploraGetAddressUtxos).Methods("GET")
+	}
+	esploraRouter.HandleFunc("/tx/{txid}", esploraGetTrx).Methods("GET")
+	esploraRouter.HandleFunc("/tx/{txid}/status", esploraGetTrxStatus).Methods("GET")
+	esploraRouter.HandleFunc("/tx/{txid}/hex", esploraGetRawTrx).Methods("GET")
+	esploraRouter.HandleFunc("/tx/{txid}/raw", esploraGetRawTrx).Methods("GET")
+	esploraRouter.HandleFunc("/tx/{txid}/merkle-proof", esploraGetMerkleProof).Methods("GET")
+	esploraRouter.HandleFunc("/tx", esploraPostTrx).Methods("POST")
+	esploraRouter.HandleFunc("/blocks/tip/height", esploraGetTipHeight).Methods("GET")
+	esploraRouter.HandleFunc("/blocks/tip/hash", esploraGetTipHash).Methods("GET")
+	esploraRouter.HandleFunc("/block-height/{height}", esploraGetBlockHashByHeight).Methods("GET")
+	esploraRouter.HandleFunc("/block/{hash}/header", esploraGetBlockHeader).Methods("GET")
+	esploraRouter.HandleFunc("/fee-estimates", esploraGetFeeEstimates).Methods("GET")
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/serialize"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+)
+
+const (
+	EstimateFeeTargetMax     = 1008
+	EstimateFeeWindowMin     = 36
+	EstimateFeeWindowMax     = 1008
+	EstimateFeeSuccessTarget = 0.95
+	// the blocks without stats or without trxs are skipped, up to this many blocks are read
+	EstimateFeeScanMax = 2 * EstimateFeeWindowMax
+)
+
+// fee rate percentiles of a block, weighted by vsize as in getblockstats
+var feeRatePercentiles = [5]float64{10, 25, 50, 75, 90}
+
+// BlockFeeStats keeps fee rates in sat/kvB so that they can be stored as integers
+type BlockFeeStats struct {
+	TrxCount    uint32
+	TotalVSize  uint64
+	TotalFee    int64
+	MinFeeRate  uint64
+	MaxFeeRate  uint64
+	Percentiles [5]uint64
+}
+
+func (f BlockFeeStats) Pack(writer io.Writer) error {
+	err := serialize.PackUint32(writer, f.TrxCount)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint64(writer, f.TotalVSize)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackInt64(writer, f.TotalFee)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint64(writer, f.MinFeeRate)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint64(writer, f.MaxFeeRate)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(f.Percentiles); i++ {
+		err = serialize.PackUint64(writer, f.Percentiles[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *BlockFeeStats) UnPack(reader io.Reader) error {
+	var err error
+	f.TrxCount, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return err
+	}
+	f.TotalVSize, err = serialize.UnPackUint64(reader)
+	if err != nil {
+		return err
+	}
+	f.TotalFee, err = serialize.UnPackInt64(reader)
+	if err != nil {
+		return err
+	}
+	f.MinFeeRate, err = serialize.UnPackUint64(reader)
+	if err != nil {
+		return err
+	}
+	f.MaxFeeRate, err = serialize.UnPackUint64(reader)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(f.Percentiles); i++ {
+		f.Percentiles[i], err = serialize.UnPackUint64(reader)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type trxFeeRate struct {
+	fee     int64
+	vsize   int
+	feeRate uint64
+}
+
+func newTrxFeeRate(fee int64, vsize int) trxFeeRate {
+	var feeRate uint64
+	if fee > 0 && vsize > 0 {
+		feeRate = uint64(fee) * 1000 / uint64(vsize)
+	}
+	return trxFeeRate{fee, vsize, feeRate}
+}
+
+// calcBlockFeeStats computes the stats of the non coinbase trxs of a block
+func calcBlockFeeStats(trxFeeRates []trxFeeRate) BlockFeeStats {
+	var feeStats BlockFeeStats
+	if len(trxFeeRates) == 0 {
+		return feeStats
+	}
+	sort.Slice(trxFeeRates, func(i, j int) bool {
+		return trxFeeRates[i].feeRate < trxFeeRates[j].feeRate
+	})
+	feeStats.TrxCount = uint32(len(trxFeeRates))
+	for _, t := range trxFeeRates {
+		feeStats.TotalVSize += uint64(t.vsize)
+		feeStats.TotalFee += t.fee
+	}
+	feeStats.MinFeeRate = trxFeeRates[0].feeRate
+	feeStats.MaxFeeRate = trxFeeRates[len(trxFeeRates)-1].feeRate
+
+	var cumulativeVSize uint64
+	index := 0
+	for _, t := range trxFeeRates {
+		cumulativeVSize += uint64(t.vsize)
+		for index < len(feeRatePercentiles) && float64(cumulativeVSize) >= float64(feeStats.TotalVSize)*feeRatePercentiles[index]/100 {
+			feeStats.Percentiles[index] = t.feeRate
+			index += 1
+		}
+	}
+	for ; index < len(feeRatePercentiles); index++ {
+		feeStats.Percentiles[index] = feeStats.MaxFeeRate
+	}
+	return feeStats
+}
+
+type BlockFeeStatsPrintAble struct {
+	BlockHeight     uint32
+	BlockHash       string
+	TrxCount        uint32
+	TotalVSize      uint64
+	TotalFee        int64
+	MinFeeRate      float64
+	MaxFeeRate      float64
+	AvgFeeRate      float64
+	FeeRate10th     float64
+	FeeRate25th     float64
+	FeeRate50th     float64
+	FeeRate75th     float64
+	FeeRate90th     float64
+	FeeRateUnitDesc string
+}
+
+func feeRateToSatPerVByte(feeRate uint64) float64 {
+	return float64(feeRate) / 1000
+}
+
+func getBlockFeeStats(blockHeight uint32) (BlockFeeStatsPrintAble, error) {
+	blockInfo, err := blockDBMgr.DBGet(blockHeight)
+	if err != nil {
+		return BlockFeeStatsPrintAble{}, newNotFoundError("block")
+	}
+	if !blockInfo.HasFeeStats {
+		return BlockFeeStatsPrintAble{}, errors.New("fee stats not found, block is indexed by an older version")
+	}
+	feeStats := blockInfo.FeeStats
+	var feeStatsPrintAble BlockFeeStatsPrintAble
+	feeStatsPrintAble.BlockHeight = blockHeight
+	feeStatsPrintAble.BlockHash = blockInfo.BlockHash.GetHex()
+	feeStatsPrintAble.TrxCount = feeStats.TrxCount
+	feeStatsPrintAble.TotalVSize = feeStats.TotalVSize
+	feeStatsPrintAble.TotalFee = feeStats.TotalFee
+	feeStatsPrintAble.MinFeeRate = feeRateToSatPerVByte(feeStats.MinFeeRate)
+	feeStatsPrintAble.MaxFeeRate = feeRateToSatPerVByte(feeStats.MaxFeeRate)
+	if feeStats.TotalVSize != 0 {
+		feeStatsPrintAble.AvgFeeRate = float64(feeStats.TotalFee) / float64(feeStats.TotalVSize)
+	}
+	feeStatsPrintAble.FeeRate10th = feeRateToSatPerVByte(feeStats.Percentiles[0])
+	feeStatsPrintAble.FeeRate25th = feeRateToSatPerVByte(feeStats.Percentiles[1])
+	feeStatsPrintAble.FeeRate50th = feeRateToSatPerVByte(feeStats.Percentiles[2])
+	feeStatsPrintAble.FeeRate75th = feeRateToSatPerVByte(feeStats.Percentiles[3])
+	feeStatsPrintAble.FeeRate90th = feeRateToSatPerVByte(feeStats.Percentiles[4])
+	feeStatsPrintAble.FeeRateUnitDesc = "sat/vB"
+	return feeStatsPrintAble, nil
+}
+
+type FeeEstimatePrintAble struct {
+	TargetBlocks uint32
+	FeeRate      float64
+	BlocksUsed   uint32
+	StartHeight  uint32
+	EndHeight    uint32
+}
+
+// estimateFee takes the 10th percentile fee rate of a block as the threshold to be included in it.
+// a trx paying the fee rate r is confirmed within n blocks with probability 1 - (1 - q)^n,
+// q is the ratio of the recent blocks whose threshold is not above r,
+// so the estimate is the lowest r with q >= 1 - (1 - EstimateFeeSuccessTarget)^(1/n)
+func estimateFee(targetBlocks uint32) (FeeEstimatePrintAble, error) {
+	if targetBlocks == 0 {
+		targetBlocks = 1
+	}
+	if targetBlocks > EstimateFeeTargetMax {
+		return FeeEstimatePrintAble{}, errors.New("target blocks is too large, max: " + strconv.Itoa(EstimateFeeTargetMax))
+	}
+	window := targetBlocks * 6
+	if window < EstimateFeeWindowMin {
+		window = EstimateFeeWindowMin
+	}
+	if window > EstimateFeeWindowMax {
+		window = EstimateFeeWindowMax
+	}
+
+	// the blocks flushed to the db, the blocks above it may be in the slot cache only
+	flushedHeight := getFlushedBlockHeight()
+	var estimate FeeEstimatePrintAble
+	estimate.TargetBlocks = targetBlocks
+	var thresholds []uint64
+	for height := flushedHeight; height > 0 && flushedHeight-height < EstimateFeeScanMax && uint32(len(thresholds)) < window; height-- {
+		blockInfo, err := blockDBMgr.DBGet(height)
+		if err != nil || !blockInfo.HasFeeStats || blockInfo.FeeStats.TrxCount == 0 {
+			// the empty blocks do not tell the fee rate
+			continue
+		}
+		if estimate.EndHeight == 0 {
+			estimate.EndHeight = height
+		}
+		estimate.StartHeight = height
+		thresholds = append(thresholds, blockInfo.FeeStats.Percentiles[0])
+	}
+	if len(thresholds) == 0 {
+		return FeeEstimatePrintAble{}, errors.New("insufficient data to estimate fee")
+	}
+	estimate.BlocksUsed = uint32(len(thresholds))
+
+	sort.Slice(thresholds, func(i, j int) bool {
+		return thresholds[i] < thresholds[j]
+	})
+	ratio := 1 - math.Pow(1-EstimateFeeSuccessTarget, 1/float64(targetBlocks))
+	index := int(math.Ceil(ratio*float64(len(thresholds)))) - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(thresholds) {
+		index = len(thresholds) - 1
+	}
+	estimate.FeeRate = feeRateToSatPerVByte(thresholds[index])
+	if estimate.FeeRate < config.BroadcastConfig.MinFeeRate {
+		estimate.FeeRate = config.BroadcastConfig.MinFeeRate
+	}
+	return estimate, nil
+}
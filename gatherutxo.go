@@ -9,14 +9,27 @@ import (
 	"github.com/mutalisk999/bitcoin-lib/src/block"
 	"github.com/mutalisk999/bitcoin-lib/src/script"
 	"github.com/mutalisk999/bitcoin-lib/src/transaction"
+	"github.com/mutalisk999/bitcoin-lib/src/utility"
 	"github.com/mutalisk999/go-lib/src/sched/goroutine_mgr"
 	"github.com/ybbus/jsonrpc"
 	"io"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
+var flushMutex = new(sync.Mutex)
+
+// flushedBlockHeight is the block height stored in the db,
+// the blocks above it are still in the slot cache and not queryable
+var flushedBlockHeight uint32
+
+func getFlushedBlockHeight() uint32 {
+	return atomic.LoadUint32(&flushedBlockHeight)
+}
+
 func doHttpJsonRpcCallType1(method string, args ...interface{}) (*jsonrpc.RPCResponse, error) {
 	rpcClient := jsonrpc.NewClient(config.RpcClientConfig.BtcWallet.RpcReqUrl)
 	rpcResponse, err := rpcClient.Call(method, args)
@@ -68,6 +81,20 @@ func getRawBlockType1(blockHash string) (string, error) {
 	return rawBlockHex, nil
 }
 
+func getRawTrxType1(trxId string, blockHash string) (string, error) {
+	rpcResponse, err := doHttpJsonRpcCallType1("getrawtransaction", trxId, false, blockHash)
+	if err != nil {
+		fmt.Println("getRawTrxType1 Failed: ", err)
+		return "", err
+	}
+	rawTrxHex, err := rpcResponse.GetString()
+	if err != nil {
+		fmt.Println("Get rawTrxHex from rpcResponse Failed: ", err)
+		return "", err
+	}
+	return rawTrxHex, nil
+}
+
 func doHttpJsonRpcCallType2(method string, args ...interface{}) (*jsonrpc.RPCResponse, error) {
 	rpcClient := jsonrpc.NewClient(config.RpcClientConfig.RawBlock.RpcReqUrl)
 	rpcResponse, err := rpcClient.Call(method, args)
@@ -119,6 +146,31 @@ func getRawBlockType2(blockHash string) (string, error) {
 	return rawBlockHex, nil
 }
 
+func getRawBlockByHash(blockHash string) (string, error) {
+	if config.RpcClientConfig.DataSource == "btcWallet" {
+		return getRawBlockType1(blockHash)
+	} else if config.RpcClientConfig.DataSource == "rawBlock" {
+		return getRawBlockType2(blockHash)
+	}
+	return "", errors.New("invalid gather type")
+}
+
+func getRawBlockByHeight(blockHeight uint32) (string, error) {
+	var blockHash string
+	var err error
+	if config.RpcClientConfig.DataSource == "btcWallet" {
+		blockHash, err = getBlockHashRpcType1(blockHeight)
+	} else if config.RpcClientConfig.DataSource == "rawBlock" {
+		blockHash, err = getBlockHashRpcType2(blockHeight)
+	} else {
+		err = errors.New("invalid gather type")
+	}
+	if err != nil {
+		return "", err
+	}
+	return getRawBlockByHash(blockHash)
+}
+
 func getStartBlockHeight() (uint32, error) {
 	var startBlockHeight uint32
 	blockHeightStr, err := globalConfigDBMgr.DBGet("blockHeight")
@@ -179,9 +231,17 @@ func applySlotCacheToDB(slotCache *SlotCache) error {
 		if err != nil {
 			return err
 		}
+		err = indexAddressScriptHash(addrStr)
+		if err != nil {
+			return err
+		}
 	}
 
 	// deal utxo
+	err := applySlotCacheToUtxoSetInfo(slotCache)
+	if err != nil {
+		return err
+	}
 	for utxoSrcStr, utxoDetail := range slotCache.UtxosAdd {
 		var utxoSrc UtxoSource
 		err := utxoSrc.FromStreamString(utxoSrcStr)
@@ -230,7 +290,93 @@ func applySlotCacheToDB(slotCache *SlotCache) error {
 			return err
 		}
 	}
+	err = pruneSpentRawTrxs(slotCache)
+	if err != nil {
+		return err
+	}
 
+	// deal trx undo
+	for trxIdStr, spentUtxos := range slotCache.TrxUndosAdd {
+		var trxId bigint.Uint256
+		err := trxId.SetData([]byte(trxIdStr))
+		if err != nil {
+			return err
+		}
+		err = trxUndoDBMgr.DBPut(trxId, spentUtxos)
+		if err != nil {
+			return err
+		}
+	}
+
+	// deal trx location
+	for trxIdStr, trxLocation := range slotCache.TrxLocsAdd {
+		var trxId bigint.Uint256
+		err := trxId.SetData([]byte(trxIdStr))
+		if err != nil {
+			return err
+		}
+		err = trxLocDBMgr.DBPut(trxId, trxLocation)
+		if err != nil {
+			return err
+		}
+	}
+
+	// deal block
+	for blockHeight, blockInfo := range slotCache.BlocksAdd {
+		err := blockDBMgr.DBPut(blockHeight, blockInfo)
+		if err != nil {
+			return err
+		}
+		err = blockHashDBMgr.DBPut(blockInfo.BlockHash, blockHeight)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flushSlotCacheToDB(blockHeight uint32) error {
+	flushMutex.Lock()
+	defer flushMutex.Unlock()
+	startTime := time.Now()
+	prevBlockHeight, err := getStartBlockHeight()
+	if err != nil {
+		return err
+	}
+	prevTrxSequence, err := getStartTrxSequence()
+	if err != nil {
+		return err
+	}
+	addrTrxsKeyHeight := startBlockHeight
+	err = applySlotCacheToDB(slotCache)
+	if err != nil {
+		return err
+	}
+	if prevBlockHeight == 0 {
+		// record the index version and the network of a new db
+		err = storeIndexVersion(atomic.LoadUint32(&indexVersion))
+		if err != nil {
+			return err
+		}
+		err = storeIndexNetwork(networkParams.Name)
+		if err != nil {
+			return err
+		}
+	}
+	err = storeStartBlockHeight(blockHeight)
+	if err != nil {
+		return err
+	}
+	err = storeStartTrxSequence(startTrxSequence)
+	if err != nil {
+		return err
+	}
+	if blockHeight > prevBlockHeight {
+		indexFlushNotifier.Notify(IndexFlushEvent{prevBlockHeight + 1, blockHeight,
+			prevTrxSequence + 1, startTrxSequence, addrTrxsKeyHeight})
+	}
+	metrics.ObserveFlush(startTime)
 	return nil
 }
 
@@ -239,6 +385,16 @@ func storeStartBlockHeight(blockHeight uint32) error {
 	if err != nil {
 		return err
 	}
+	atomic.StoreUint32(&flushedBlockHeight, blockHeight)
+	return nil
+}
+
+func loadFlushedBlockHeight() error {
+	blockHeight, err := getStartBlockHeight()
+	if err != nil {
+		return err
+	}
+	atomic.StoreUint32(&flushedBlockHeight, blockHeight)
 	return nil
 }
 
@@ -250,7 +406,28 @@ func storeStartTrxSequence(trxSequence uint32) error {
 	return nil
 }
 
-func dealWithVinToCache(trxSeq uint32, vin transaction.TxIn, trxId bigint.Uint256) error {
+func getAddressFromScript(scriptPubKey script.Script) string {
+	addrStr := ""
+	isSucc, scriptType, addresses := script.ExtractDestination(scriptPubKey)
+	if isSucc {
+		// bitcoin-lib encodes the mainnet addresses
+		for i := range addresses {
+			addresses[i], _ = convertAddressNetwork(addresses[i], networkParams)
+		}
+		if script.IsSingleAddress(scriptType) {
+			addrStr = addresses[0]
+		} else if script.IsMultiAddress(scriptType) {
+			addrStr = strings.Join(addresses, ",")
+		} else if scriptType == script.TX_WITNESS_UNKNOWN && isTaprootIndexed() {
+			// witness v1+ (taproot) is encoded with bech32m
+			_, _, vSolutions := script.Solver(scriptPubKey)
+			addrStr, _ = encodeSegWitAddress(networkParams.SegWitHrp, vSolutions[0][0], vSolutions[1])
+		}
+	}
+	return addrStr
+}
+
+func dealWithVinToCache(trxSeq uint32, vin transaction.TxIn, trxId bigint.Uint256) (int64, error) {
 	// deal trx utxo pair
 	// query from slot cache, if not found, query from leveldb
 	var utxoSource UtxoSource
@@ -261,49 +438,34 @@ func dealWithVinToCache(trxSeq uint32, vin transaction.TxIn, trxId bigint.Uint25
 		var err error
 		utxoDetail, err = utxoDBMgr.DBGet(utxoSource)
 		if err != nil && err.Error() == NotFoundError {
-			return errors.New("can not find prevout trxid: " + vin.PrevOut.Hash.GetHex() + ", vout: " + strconv.Itoa(int(vin.PrevOut.N)))
+			return 0, errors.New("can not find prevout trxid: " + vin.PrevOut.Hash.GetHex() + ", vout: " + strconv.Itoa(int(vin.PrevOut.N)))
 		}
 	}
-	err := slotCache.DelUtxo(utxoSource)
+	err := slotCache.DelUtxo(utxoSource, utxoDetail)
 	if err != nil {
-		return err
+		return 0, err
+	}
+	if config.GatherConfig.StoreTrxUndo {
+		// keep the spent prevout, so that the input value can be resolved later
+		slotCache.AddTrxUndo(string(trxId.GetData()), SpentUtxo{utxoSource, utxoDetail})
 	}
 
-	scriptPubKey := utxoDetail.ScriptPubKey
 	// deal address trx pair
-	isSucc, scriptType, addresses := script.ExtractDestination(scriptPubKey)
-	if isSucc {
-		addrStr := ""
-		if script.IsSingleAddress(scriptType) {
-			addrStr = addresses[0]
-		} else if script.IsMultiAddress(scriptType) {
-			addrStr = strings.Join(addresses, ",")
-		}
-		if addrStr != "" {
-			// add to slot cache
-			slotCache.AddAddrTrx(addrStr, trxSeq)
-		}
+	addrStr := getAddressFromScript(utxoDetail.ScriptPubKey)
+	if addrStr != "" {
+		// add to slot cache
+		slotCache.AddAddrTrx(addrStr, trxSeq)
 	}
-	return nil
+	return utxoDetail.Amount, nil
 }
 
 func dealWithVoutToCache(blockHeight uint32, trxSeq uint32, vout transaction.TxOut, trxId bigint.Uint256, index uint32) error {
-	var scriptPubKey script.Script
-	var addrStr string
-
-	scriptPubKey = vout.ScriptPubKey
+	scriptPubKey := vout.ScriptPubKey
 	// deal address trx pair
-	isSucc, scriptType, addresses := script.ExtractDestination(scriptPubKey)
-	if isSucc {
-		if script.IsSingleAddress(scriptType) {
-			addrStr = addresses[0]
-		} else if script.IsMultiAddress(scriptType) {
-			addrStr = strings.Join(addresses, ",")
-		}
-		if addrStr != "" {
-			// add to slot cache
-			slotCache.AddAddrTrx(addrStr, trxSeq)
-		}
+	addrStr := getAddressFromScript(scriptPubKey)
+	if addrStr != "" {
+		// add to slot cache
+		slotCache.AddAddrTrx(addrStr, trxSeq)
 	}
 	// deal trx utxo pair
 	var utxoSource UtxoSource
@@ -343,41 +505,74 @@ func dealWithTrxSeqToCache(trxSeq uint32, trxId bigint.Uint256) error {
 	return nil
 }
 
-func dealWithTrxToCache(blockHeight uint32, trx *transaction.Transaction, isCoinBase bool) error {
+func dealWithTrxLocToCache(trxId bigint.Uint256, trxLocation TrxLocation) error {
+	slotCache.AddTrxLoc(string(trxId.GetData()), trxLocation)
+	return nil
+}
+
+func dealWithTrxToCache(blockHeight uint32, blockHash bigint.Uint256, blockIndex uint32, trx *transaction.Transaction, isCoinBase bool) (int64, int64, error) {
 	trxId, err := trx.CalcTrxId()
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
+	var valueIn int64 = 0
+	var valueOut int64 = 0
 	newTrxSequence := startTrxSequence + 1
 	if !isCoinBase {
 		for _, vin := range trx.Vin {
-			err := dealWithVinToCache(newTrxSequence, vin, trxId)
+			value, err := dealWithVinToCache(newTrxSequence, vin, trxId)
 			if err != nil {
-				return err
+				return 0, 0, err
 			}
+			valueIn += value
 		}
 	}
 	for index, vout := range trx.Vout {
 		err := dealWithVoutToCache(blockHeight, newTrxSequence, vout, trxId, uint32(index))
 		if err != nil {
-			return err
+			return 0, 0, err
 		}
+		valueOut += vout.Value
 	}
 
 	err = dealWithTrxSeqToCache(newTrxSequence, trxId)
 	if err != nil {
-		return err
+		return 0, 0, err
+	}
+	err = dealWithTrxLocToCache(trxId, TrxLocation{blockHeight, blockIndex, newTrxSequence, blockHash})
+	if err != nil {
+		return 0, 0, err
 	}
 	if config.GatherConfig.StoreRawTrx {
 		err = dealWithRawTrxToCache(trxId, trx)
 		if err != nil {
-			return err
+			return 0, 0, err
 		}
 	}
 	startTrxSequence = newTrxSequence
 
-	return nil
+	// fee of coinbase is not applicable
+	var fee int64 = 0
+	if !isCoinBase {
+		fee = valueIn - valueOut
+	}
+	return fee, valueOut, nil
+}
+
+func calcBlockHash(blockHeader *block.BlockHeader) (bigint.Uint256, error) {
+	bytesBuf := bytes.NewBuffer([]byte{})
+	bufWriter := io.Writer(bytesBuf)
+	err := blockHeader.Pack(bufWriter)
+	if err != nil {
+		return bigint.Uint256{}, err
+	}
+	var blockHash bigint.Uint256
+	err = blockHash.SetData(utility.Sha256(utility.Sha256(bytesBuf.Bytes())))
+	if err != nil {
+		return bigint.Uint256{}, err
+	}
+	return blockHash, nil
 }
 
 func dealWithRawBlock(blockHeight uint32, rawBlockData *string) error {
@@ -389,16 +584,41 @@ func dealWithRawBlock(blockHeight uint32, rawBlockData *string) error {
 	bufReader := io.Reader(bytesBuf)
 	var blockNew block.Block
 	_ = blockNew.UnPack(bufReader)
+	blockHash, err := calcBlockHash(&blockNew.Header)
+	if err != nil {
+		return err
+	}
+
+	var blockInfo BlockInfo
+	blockInfo.BlockHash = blockHash
+	blockInfo.Header = blockNew.Header
+	blockInfo.Size = uint32(len(blockBytes))
+	blockInfo.FirstTrxSeq = startTrxSequence + 1
+	blockInfo.TrxCount = uint32(len(blockNew.Vtx))
+	trxFeeRates := make([]trxFeeRate, 0, len(blockNew.Vtx))
 	for i := 0; i < len(blockNew.Vtx); i++ {
 		isCoinBase := false
 		if i == 0 {
 			isCoinBase = true
 		}
-		err = dealWithTrxToCache(blockHeight, &blockNew.Vtx[i], isCoinBase)
+		fee, valueOut, err := dealWithTrxToCache(blockHeight, blockHash, uint32(i), &blockNew.Vtx[i], isCoinBase)
 		if err != nil {
 			return err
 		}
+		blockInfo.TotalOut += valueOut
+		blockInfo.TotalFee += fee
+		if !isCoinBase {
+			_, vsize, _, err := calcTrxSize(&blockNew.Vtx[i])
+			if err != nil {
+				return err
+			}
+			trxFeeRates = append(trxFeeRates, newTrxFeeRate(fee, vsize))
+		}
 	}
+	blockInfo.HasFeeStats = true
+	blockInfo.FeeStats = calcBlockFeeStats(trxFeeRates)
+	slotCache.AddBlock(blockHeight, blockInfo)
+	metrics.AddProcessedBlock(blockInfo.TrxCount)
 	return nil
 }
 
@@ -425,6 +645,7 @@ func doGatherUtxoType1(goroutine goroutine_mgr.Goroutine, args ...interface{}) {
 		if err != nil {
 			break
 		}
+		metrics.SetNodeBlockHeight(blockCount)
 
 		if startBlockHeight >= blockCount {
 			time.Sleep(5 * 1000 * 1000 * 1000)
@@ -460,17 +681,7 @@ func doGatherUtxoType1(goroutine goroutine_mgr.Goroutine, args ...interface{}) {
 					break
 				}
 				if (startBlockHeight > blockCount-20) || ((startBlockHeight%config.CacheConfig.SamplingBlockCount == 0) && (slotCache.CalcObjectCacheWeight() > config.CacheConfig.ObjectCacheWeightMax)) {
-					err = applySlotCacheToDB(slotCache)
-					if err != nil {
-						quitFlag = true
-						break
-					}
-					err = storeStartBlockHeight(newBlockHeight)
-					if err != nil {
-						quitFlag = true
-						break
-					}
-					err = storeStartTrxSequence(startTrxSequence)
+					err = flushSlotCacheToDB(newBlockHeight)
 					if err != nil {
 						quitFlag = true
 						break
@@ -486,17 +697,7 @@ func doGatherUtxoType1(goroutine goroutine_mgr.Goroutine, args ...interface{}) {
 			}
 			if config.CacheConfig.FlushCacheOnQuit {
 				// need to flush slot cache
-				err = applySlotCacheToDB(slotCache)
-				if err != nil {
-					quitFlag = true
-					break
-				}
-				err = storeStartBlockHeight(startBlockHeight)
-				if err != nil {
-					quitFlag = true
-					break
-				}
-				err = storeStartTrxSequence(startTrxSequence)
+				err = flushSlotCacheToDB(startBlockHeight)
 				if err != nil {
 					quitFlag = true
 					break
@@ -541,6 +742,7 @@ func doGatherUtxoType2(goroutine goroutine_mgr.Goroutine, args ...interface{}) {
 		if err != nil {
 			break
 		}
+		metrics.SetNodeBlockHeight(blockCount)
 
 		if startBlockHeight >= blockCount {
 			time.Sleep(5 * time.Second)
@@ -576,17 +778,7 @@ func doGatherUtxoType2(goroutine goroutine_mgr.Goroutine, args ...interface{}) {
 					break
 				}
 				if (startBlockHeight > blockCount-20) || ((startBlockHeight%config.CacheConfig.SamplingBlockCount == 0) && (slotCache.CalcObjectCacheWeight() > config.CacheConfig.ObjectCacheWeightMax)) {
-					err = applySlotCacheToDB(slotCache)
-					if err != nil {
-						quitFlag = true
-						break
-					}
-					err = storeStartBlockHeight(NewBlockHeight)
-					if err != nil {
-						quitFlag = true
-						break
-					}
-					err = storeStartTrxSequence(startTrxSequence)
+					err = flushSlotCacheToDB(NewBlockHeight)
 					if err != nil {
 						quitFlag = true
 						break
@@ -602,17 +794,7 @@ func doGatherUtxoType2(goroutine goroutine_mgr.Goroutine, args ...interface{}) {
 			}
 			if config.CacheConfig.FlushCacheOnQuit {
 				// need to flush slot cache
-				err = applySlotCacheToDB(slotCache)
-				if err != nil {
-					quitFlag = true
-					break
-				}
-				err = storeStartBlockHeight(startBlockHeight)
-				if err != nil {
-					quitFlag = true
-					break
-				}
-				err = storeStartTrxSequence(startTrxSequence)
+				err = flushSlotCacheToDB(startBlockHeight)
 				if err != nil {
 					quitFlag = true
 					break
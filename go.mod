@@ -6,6 +6,7 @@ require (
 	github.com/facebookgo/ensure v0.0.0-20200202191622-63f1cf65ac4c // indirect
 	github.com/facebookgo/stack v0.0.0-20160209184415-751773369052 // indirect
 	github.com/facebookgo/subset v0.0.0-20200203212716-c811ad88dec4 // indirectK
+	github.com/golang/protobuf v1.4.3
 	github.com/gorilla/mux v1.8.0
 	github.com/gorilla/rpc v1.2.0
 	github.com/mutalisk999/bitcoin-lib v0.0.0-20200608160650-d184f2ce1133
@@ -14,4 +15,7 @@ require (
 	github.com/syndtr/goleveldb v1.0.0
 	github.com/tecbot/gorocksdb v0.0.0-20191217155057-f0fad39f321c
 	github.com/ybbus/jsonrpc v2.1.2+incompatible
+	golang.org/x/crypto v0.0.0-20201016220609-9e8e0b390897
+	google.golang.org/grpc v1.33.2
+	google.golang.org/protobuf v1.25.0
 )
@@ -0,0 +1,442 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/mutalisk999/bitcoin-spv-server/protos"
+	"github.com/mutalisk999/go-lib/src/sched/goroutine_mgr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type TrxNotificationPrintAble struct {
+	TrxStatusPrintAble
+	Addresses []string
+}
+
+// GrpcService exposes the operations of Service, the replies are converted by the json layout they share
+type GrpcService struct {
+	protos.UnimplementedSpvServiceServer
+}
+
+func toProtoMessage(v interface{}, m proto.Message) error {
+	bytesJson, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(bytesJson, m)
+}
+
+func getGrpcError(err error) error {
+	errStr := err.Error()
+	if errors.Is(err, errNotFound) {
+		return status.Error(codes.NotFound, errStr)
+	}
+	if strings.HasPrefix(errStr, "invalid") {
+		return status.Error(codes.InvalidArgument, errStr)
+	}
+	return status.Error(codes.Unknown, errStr)
+}
+
+func getGrpcReply(v interface{}, m proto.Message, err error) error {
+	if err != nil {
+		return getGrpcError(err)
+	}
+	err = toProtoMessage(v, m)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func getUtxoSourcePrintAbles(utxoSrcs []*protos.UtxoSource) []UtxoSourcePrintAble {
+	utxos := make([]UtxoSourcePrintAble, 0, len(utxoSrcs))
+	for _, utxoSrc := range utxoSrcs {
+		utxos = append(utxos, UtxoSourcePrintAble{utxoSrc.TrxId, utxoSrc.Vout})
+	}
+	return utxos
+}
+
+func (g *GrpcService) GetBlockCount(ctx context.Context, args *protos.EmptyArgs) (*protos.CountReply, error) {
+	var count uint32
+	err := new(Service).GetBlockCount(nil, nil, &count)
+	reply := new(protos.CountReply)
+	return reply, getGrpcReply(struct{ Count uint32 }{count}, reply, err)
+}
+
+func (g *GrpcService) GetTrxCount(ctx context.Context, args *protos.EmptyArgs) (*protos.CountReply, error) {
+	var count uint32
+	err := new(Service).GetTrxCount(nil, nil, &count)
+	reply := new(protos.CountReply)
+	return reply, getGrpcReply(struct{ Count uint32 }{count}, reply, err)
+}
+
+func (g *GrpcService) GetTrxIdBySeq(ctx context.Context, args *protos.TrxSeqArgs) (*protos.TrxIdReply, error) {
+	var trxId string
+	err := new(Service).GetTrxIdBySeq(nil, &args.TrxSeq, &trxId)
+	reply := new(protos.TrxIdReply)
+	return reply, getGrpcReply(struct{ TrxId string }{trxId}, reply, err)
+}
+
+func (g *GrpcService) GetAddressTrxs(ctx context.Context, args *protos.AddressArgs) (*protos.TrxStatusList, error) {
+	var trxs []TrxStatusPrintAble
+	err := new(Service).GetAddressTrxsVerbose(nil, &args.Address, &trxs)
+	reply := new(protos.TrxStatusList)
+	return reply, getGrpcReply(struct{ Trxs []TrxStatusPrintAble }{trxs}, reply, err)
+}
+
+func (g *GrpcService) GetTrxStatus(ctx context.Context, args *protos.TrxIdArgs) (*protos.TrxStatus, error) {
+	var trxStatus TrxStatusPrintAble
+	err := new(Service).GetTrxStatus(nil, &args.TrxId, &trxStatus)
+	reply := new(protos.TrxStatus)
+	return reply, getGrpcReply(trxStatus, reply, err)
+}
+
+func (g *GrpcService) GetBlock(ctx context.Context, args *protos.BlockQueryArgs) (*protos.Block, error) {
+	var blockPrintAble BlockPrintAble
+	err := new(Service).GetBlock(nil, &BlockQueryArgs{args.HeightOrHash, args.Start, args.Count}, &blockPrintAble)
+	reply := new(protos.Block)
+	return reply, getGrpcReply(blockPrintAble, reply, err)
+}
+
+func (g *GrpcService) GetRawTrx(ctx context.Context, args *protos.TrxIdArgs) (*protos.RawTrxReply, error) {
+	var rawTrx string
+	err := new(Service).GetRawTrx(nil, &args.TrxId, &rawTrx)
+	reply := new(protos.RawTrxReply)
+	return reply, getGrpcReply(struct{ RawTrx string }{rawTrx}, reply, err)
+}
+
+func (g *GrpcService) GetTrx(ctx context.Context, args *protos.TrxIdArgs) (*protos.Trx, error) {
+	var trxPrintAble TrxPrintAble
+	err := new(Service).GetTrx(nil, &args.TrxId, &trxPrintAble)
+	reply := new(protos.Trx)
+	return reply, getGrpcReply(trxPrintAble, reply, err)
+}
+
+func (g *GrpcService) GetTrxVerbose(ctx context.Context, args *protos.TrxIdArgs) (*protos.TrxVerbose, error) {
+	var trxVerbose TrxVerbosePrintAble
+	err := new(Service).GetTrxVerbose(nil, &args.TrxId, &trxVerbose)
+	reply := new(protos.TrxVerbose)
+	return reply, getGrpcReply(trxVerbose, reply, err)
+}
+
+func (g *GrpcService) GetUtxo(ctx context.Context, args *protos.UtxoSource) (*protos.UtxoDetail, error) {
+	var utxoDetail UtxoDetailPrintAble
+	err := new(Service).GetUtxo(nil, &UtxoSourcePrintAble{args.TrxId, args.Vout}, &utxoDetail)
+	reply := new(protos.UtxoDetail)
+	return reply, getGrpcReply(utxoDetail, reply, err)
+}
+
+func (g *GrpcService) ListUnSpent(ctx context.Context, args *protos.AddressArgs) (*protos.UtxoDetailList, error) {
+	var utxos []UtxoDetailPrintAble
+	err := new(Service).ListUnSpent(nil, &args.Address, &utxos)
+	reply := new(protos.UtxoDetailList)
+	return reply, getGrpcReply(struct{ Utxos []UtxoDetailPrintAble }{utxos}, reply, err)
+}
+
+func (g *GrpcService) GetAddressesTrxs(ctx context.Context, args *protos.AddressesArgs) (*protos.AddressesTrxs, error) {
+	var addressesTrxs AddressesTrxsPrintAble
+	err := new(Service).GetAddressesTrxs(nil, &args.Addresses, &addressesTrxs)
+	reply := new(protos.AddressesTrxs)
+	return reply, getGrpcReply(addressesTrxs, reply, err)
+}
+
+func (g *GrpcService) ListUnSpentMulti(ctx context.Context, args *protos.AddressesArgs) (*protos.AddressUtxosList, error) {
+	var addressesUtxos []AddressUtxosPrintAble
+	err := new(Service).ListUnSpentMulti(nil, &args.Addresses, &addressesUtxos)
+	reply := new(protos.AddressUtxosList)
+	return reply, getGrpcReply(struct{ Addresses []AddressUtxosPrintAble }{addressesUtxos}, reply, err)
+}
+
+func (g *GrpcService) GetAddressesBalance(ctx context.Context, args *protos.AddressesArgs) (*protos.AddressesBalance, error) {
+	var addressesBalance AddressesBalancePrintAble
+	err := new(Service).GetAddressesBalance(nil, &args.Addresses, &addressesBalance)
+	reply := new(protos.AddressesBalance)
+	return reply, getGrpcReply(addressesBalance, reply, err)
+}
+
+func (g *GrpcService) ScanXpub(ctx context.Context, args *protos.XpubScanArgs) (*protos.XpubScan, error) {
+	var xpubScan XpubScanPrintAble
+	err := new(Service).ScanXpub(nil, &XpubScanArgs{args.Xpub, args.ScriptType, args.GapLimit}, &xpubScan)
+	reply := new(protos.XpubScan)
+	return reply, getGrpcReply(xpubScan, reply, err)
+}
+
+func (g *GrpcService) ScanDescriptor(ctx context.Context, args *protos.DescriptorScanArgs) (*protos.DescriptorScan, error) {
+	var descriptorScan DescriptorScanPrintAble
+	err := new(Service).ScanDescriptor(nil, &DescriptorScanArgs{args.Descriptor_, args.RangeStart, args.RangeEnd}, &descriptorScan)
+	reply := new(protos.DescriptorScan)
+	return reply, getGrpcReply(descriptorScan, reply, err)
+}
+
+func (g *GrpcService) SendRawTrx(ctx context.Context, args *protos.RawTrxArgs) (*protos.SendRawTrxReply, error) {
+	var sendResult SendRawTrxPrintAble
+	err := new(Service).SendRawTrx(nil, &args.RawTrx, &sendResult)
+	reply := new(protos.SendRawTrxReply)
+	return reply, getGrpcReply(sendResult, reply, err)
+}
+
+func (g *GrpcService) EstimateFee(ctx context.Context, args *protos.EstimateFeeArgs) (*protos.FeeEstimate, error) {
+	var feeEstimate FeeEstimatePrintAble
+	err := new(Service).EstimateFee(nil, &args.TargetBlocks, &feeEstimate)
+	reply := new(protos.FeeEstimate)
+	return reply, getGrpcReply(feeEstimate, reply, err)
+}
+
+func (g *GrpcService) GetBlockFeeStats(ctx context.Context, args *protos.BlockHeightArgs) (*protos.BlockFeeStats, error) {
+	var blockFeeStats BlockFeeStatsPrintAble
+	err := new(Service).GetBlockFeeStats(nil, &args.BlockHeight, &blockFeeStats)
+	reply := new(protos.BlockFeeStats)
+	return reply, getGrpcReply(blockFeeStats, reply, err)
+}
+
+func (g *GrpcService) CreatePsbt(ctx context.Context, args *protos.CreatePsbtArgs) (*protos.CreatePsbtReply, error) {
+	var psbtArgs CreatePsbtArgs
+	psbtArgs.FromAddresses = args.FromAddresses
+	for _, output := range args.Outputs {
+		psbtArgs.Outputs = append(psbtArgs.Outputs, PsbtOutputArgs{output.Address, output.Amount})
+	}
+	psbtArgs.FeeRate = args.FeeRate
+	psbtArgs.ChangeAddress = args.ChangeAddress
+	psbtArgs.IncludeLocked = args.IncludeLocked
+	psbtArgs.P2shScriptType = args.P2ShScriptType
+	var createPsbt CreatePsbtPrintAble
+	err := new(Service).CreatePsbt(nil, &psbtArgs, &createPsbt)
+	reply := new(protos.CreatePsbtReply)
+	return reply, getGrpcReply(createPsbt, reply, err)
+}
+
+func (g *GrpcService) LockUnspent(ctx context.Context, args *protos.LockUnspentArgs) (*protos.LockUnspentReply, error) {
+	var lockUnspentPrintAble LockUnspentPrintAble
+	err := new(Service).LockUnspent(nil, &LockUnspentArgs{getUtxoSourcePrintAbles(args.Utxos), args.TTL, args.Label, args.Owner}, &lockUnspentPrintAble)
+	reply := new(protos.LockUnspentReply)
+	return reply, getGrpcReply(lockUnspentPrintAble, reply, err)
+}
+
+func (g *GrpcService) UnlockUnspent(ctx context.Context, args *protos.UnlockUnspentArgs) (*protos.CountReply, error) {
+	var count uint32
+	err := new(Service).UnlockUnspent(nil, &UnlockUnspentArgs{getUtxoSourcePrintAbles(args.Utxos), args.Owner}, &count)
+	reply := new(protos.CountReply)
+	return reply, getGrpcReply(struct{ Count uint32 }{count}, reply, err)
+}
+
+func (g *GrpcService) ListLocked(ctx context.Context, args *protos.EmptyArgs) (*protos.UtxoLockList, error) {
+	var utxoLocks []UtxoLockPrintAble
+	err := new(Service).ListLocked(nil, nil, &utxoLocks)
+	reply := new(protos.UtxoLockList)
+	return reply, getGrpcReply(struct{ Locks []UtxoLockPrintAble }{utxoLocks}, reply, err)
+}
+
+func (g *GrpcService) GetUtxoSetInfo(ctx context.Context, args *protos.EmptyArgs) (*protos.UtxoSetInfo, error) {
+	var utxoSetInfoPrintAble UtxoSetInfoPrintAble
+	err := new(Service).GetUtxoSetInfo(nil, nil, &utxoSetInfoPrintAble)
+	reply := new(protos.UtxoSetInfo)
+	return reply, getGrpcReply(utxoSetInfoPrintAble, reply, err)
+}
+
+func (g *GrpcService) BackupDB(ctx context.Context, args *protos.BackupArgs) (*protos.BackupManifest, error) {
+	var manifest BackupManifest
+	err := new(Service).BackupDB(nil, &args.DstDir, &manifest)
+	reply := new(protos.BackupManifest)
+	return reply, getGrpcReply(manifest, reply, err)
+}
+
+func (g *GrpcService) VerifyIndex(ctx context.Context, args *protos.VerifyArgs) (*protos.VerifyReport, error) {
+	var report VerifyReport
+	err := new(Service).VerifyIndex(nil, &VerifyArgs{args.FromHeight, args.ToHeight}, &report)
+	reply := new(protos.VerifyReport)
+	return reply, getGrpcReply(report, reply, err)
+}
+
+func (g *GrpcService) StreamAddressTrxs(args *protos.AddressArgs, stream protos.SpvService_StreamAddressTrxsServer) error {
+	trxSeqs, err := getAddressTrxSeqs(args.Address)
+	if err != nil {
+		return getGrpcError(newNotFoundError("address"))
+	}
+	trxSeqPrev := uint32(0)
+	for _, trxSeq := range trxSeqs {
+		if trxSeq == trxSeqPrev {
+			continue
+		}
+		trxSeqPrev = trxSeq
+		trxStatus, err := getTrxStatusBySeq(trxSeq)
+		if err != nil {
+			continue
+		}
+		reply := new(protos.TrxStatus)
+		err = getGrpcReply(trxStatus, reply, nil)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(reply)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *GrpcService) SubscribeBlocks(args *protos.SubscribeBlocksArgs, stream protos.SpvService_SubscribeBlocksServer) error {
+	// subscribe before reading the flushed height, so that no block is missed
+	eventChan := indexFlushNotifier.Subscribe()
+	defer indexFlushNotifier.UnSubscribe(eventChan)
+	flushedBlockHeight, err := getStartBlockHeight()
+	if err != nil {
+		return getGrpcError(err)
+	}
+	nextBlockHeight := args.FromHeight
+	if nextBlockHeight == 0 {
+		nextBlockHeight = flushedBlockHeight + 1
+	}
+	sendBlocks := func(toBlockHeight uint32) error {
+		for ; nextBlockHeight <= toBlockHeight; nextBlockHeight++ {
+			blockInfo, err := blockDBMgr.DBGet(nextBlockHeight)
+			if err != nil {
+				return getGrpcError(newNotFoundError("block " + strconv.Itoa(int(nextBlockHeight))))
+			}
+			reply := new(protos.Block)
+			err = getGrpcReply(blockInfo.GetBlockPrintAble(nextBlockHeight), reply, nil)
+			if err != nil {
+				return err
+			}
+			err = stream.Send(reply)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	err = sendBlocks(flushedBlockHeight)
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-eventChan:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber falls behind")
+			}
+			err = sendBlocks(event.ToBlockHeight)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func getFlushedTrxNotifications(event IndexFlushEvent, addresses []string) ([]TrxNotificationPrintAble, error) {
+	var trxSeqs []uint32
+	trxAddresses := make(map[uint32][]string)
+	if len(addresses) == 0 {
+		for trxSeq := event.FromTrxSeq; trxSeq <= event.ToTrxSeq && trxSeq != 0; trxSeq++ {
+			trxSeqs = append(trxSeqs, trxSeq)
+		}
+	} else {
+		keySuffix := "." + strconv.Itoa(int(event.AddrTrxsKeyHeight))
+		for _, addrStr := range addresses {
+			addrTrxSeqs, err := addrTrxsDBMgr.DBGet(addrStr + keySuffix)
+			if err != nil {
+				continue
+			}
+			for _, trxSeq := range addrTrxSeqs {
+				if trxSeq < event.FromTrxSeq || trxSeq > event.ToTrxSeq {
+					continue
+				}
+				if len(trxAddresses[trxSeq]) == 0 {
+					trxSeqs = append(trxSeqs, trxSeq)
+				}
+				trxAddresses[trxSeq] = append(trxAddresses[trxSeq], addrStr)
+			}
+		}
+		sort.Slice(trxSeqs, func(i, j int) bool { return trxSeqs[i] < trxSeqs[j] })
+	}
+	trxNotifications := make([]TrxNotificationPrintAble, 0, len(trxSeqs))
+	for _, trxSeq := range trxSeqs {
+		trxStatus, err := getTrxStatusBySeq(trxSeq)
+		if err != nil {
+			return nil, err
+		}
+		trxNotifications = append(trxNotifications, TrxNotificationPrintAble{trxStatus, trxAddresses[trxSeq]})
+	}
+	return trxNotifications, nil
+}
+
+func (g *GrpcService) SubscribeTrxs(args *protos.SubscribeTrxsArgs, stream protos.SpvService_SubscribeTrxsServer) error {
+	var addresses []string
+	if len(args.Addresses) != 0 {
+		var err error
+		addresses, err = uniqueAddresses(args.Addresses)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	eventChan := indexFlushNotifier.Subscribe()
+	defer indexFlushNotifier.UnSubscribe(eventChan)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-eventChan:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber falls behind")
+			}
+			trxNotifications, err := getFlushedTrxNotifications(event, addresses)
+			if err != nil {
+				return getGrpcError(err)
+			}
+			for _, trxNotification := range trxNotifications {
+				reply := new(protos.TrxNotification)
+				err = getGrpcReply(trxNotification, reply, nil)
+				if err != nil {
+					return err
+				}
+				err = stream.Send(reply)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func grpcServer(goroutine goroutine_mgr.Goroutine, args ...interface{}) {
+	defer goroutine.OnQuit()
+	server := args[0].(*grpc.Server)
+	listener := args[1].(net.Listener)
+	err := server.Serve(listener)
+	if err != nil {
+		fmt.Println("grpcServer", err)
+	}
+}
+
+// startGrpcServer listens before the goroutine is created, so that the listen and tls errors fail the startup
+func startGrpcServer() (uint64, error) {
+	listener, err := net.Listen("tcp", config.RpcServerConfig.GrpcListenEndPoint)
+	if err != nil {
+		return 0, err
+	}
+	serverOptions := []grpc.ServerOption{grpc.UnaryInterceptor(authUnaryInterceptor), grpc.StreamInterceptor(authStreamInterceptor)}
+	if config.RpcServerConfig.TlsCertFile != "" {
+		creds, err := credentials.NewServerTLSFromFile(config.RpcServerConfig.TlsCertFile, config.RpcServerConfig.TlsKeyFile)
+		if err != nil {
+			_ = listener.Close()
+			return 0, err
+		}
+		serverOptions = append(serverOptions, grpc.Creds(creds))
+	}
+	server := grpc.NewServer(serverOptions...)
+	protos.RegisterSpvServiceServer(server, new(GrpcService))
+	return goroutineMgr.GoroutineCreatePn("grpcserver", grpcServer, server, listener), nil
+}
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/base58"
+	"github.com/mutalisk999/bitcoin-lib/src/utility"
+	"math/big"
+	"sync"
+)
+
+const (
+	HardenedKeyStart = 0x80000000
+)
+
+// secp256k1 curve parameters
+var (
+	secp256k1P, _  = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+	secp256k1N, _  = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+	secp256k1Gx, _ = new(big.Int).SetString("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", 16)
+	secp256k1Gy, _ = new(big.Int).SetString("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8", 16)
+)
+
+type ecPoint struct {
+	x *big.Int
+	y *big.Int
+}
+
+func (p ecPoint) isInfinity() bool {
+	return p.x == nil
+}
+
+func ecAdd(p1 ecPoint, p2 ecPoint) ecPoint {
+	if p1.isInfinity() {
+		return p2
+	}
+	if p2.isInfinity() {
+		return p1
+	}
+	var lambda *big.Int
+	if p1.x.Cmp(p2.x) == 0 {
+		ySum := new(big.Int).Add(p1.y, p2.y)
+		if ySum.Mod(ySum, secp256k1P).Sign() == 0 {
+			return ecPoint{}
+		}
+		// doubling, lambda = 3x^2 / 2y
+		num := new(big.Int).Mul(p1.x, p1.x)
+		num.Mul(num, big.NewInt(3))
+		den := new(big.Int).Lsh(p1.y, 1)
+		den.ModInverse(den, secp256k1P)
+		lambda = num.Mul(num, den)
+	} else {
+		// lambda = (y2 - y1) / (x2 - x1)
+		num := new(big.Int).Sub(p2.y, p1.y)
+		den := new(big.Int).Sub(p2.x, p1.x)
+		den.Mod(den, secp256k1P)
+		den.ModInverse(den, secp256k1P)
+		lambda = num.Mul(num, den)
+	}
+	lambda.Mod(lambda, secp256k1P)
+	x3 := new(big.Int).Mul(lambda, lambda)
+	x3.Sub(x3, p1.x)
+	x3.Sub(x3, p2.x)
+	x3.Mod(x3, secp256k1P)
+	y3 := new(big.Int).Sub(p1.x, x3)
+	y3.Mul(y3, lambda)
+	y3.Sub(y3, p1.y)
+	y3.Mod(y3, secp256k1P)
+	return ecPoint{x3, y3}
+}
+
+// the points j * 16^w * G of the 4 bit windows w of a scalar, computed once
+var (
+	ecBaseTable     [64][16]ecPoint
+	ecBaseTableOnce sync.Once
+)
+
+func initEcBaseTable() {
+	base := ecPoint{secp256k1Gx, secp256k1Gy}
+	for w := range ecBaseTable {
+		for j := 1; j < 16; j++ {
+			ecBaseTable[w][j] = ecAdd(ecBaseTable[w][j-1], base)
+		}
+		base = ecAdd(ecBaseTable[w][15], base)
+	}
+}
+
+// ecJacobianPoint is (x / z^2, y / z^3), the sums need no inverse until the conversion back
+type ecJacobianPoint struct {
+	x *big.Int
+	y *big.Int
+	z *big.Int
+}
+
+func (p ecJacobianPoint) isInfinity() bool {
+	return p.z.Sign() == 0
+}
+
+func ecJacobianDouble(p ecJacobianPoint) ecJacobianPoint {
+	if p.isInfinity() || p.y.Sign() == 0 {
+		return ecJacobianPoint{new(big.Int), new(big.Int), new(big.Int)}
+	}
+	// a = x^2, b = y^2, c = b^2, d = 2((x + b)^2 - a - c), e = 3a
+	a := new(big.Int).Mul(p.x, p.x)
+	a.Mod(a, secp256k1P)
+	b := new(big.Int).Mul(p.y, p.y)
+	b.Mod(b, secp256k1P)
+	c := new(big.Int).Mul(b, b)
+	c.Mod(c, secp256k1P)
+	d := new(big.Int).Add(p.x, b)
+	d.Mul(d, d)
+	d.Sub(d, a)
+	d.Sub(d, c)
+	d.Lsh(d, 1)
+	d.Mod(d, secp256k1P)
+	e := new(big.Int).Mul(a, big.NewInt(3))
+	// x3 = e^2 - 2d, y3 = e(d - x3) - 8c, z3 = 2yz
+	x3 := new(big.Int).Mul(e, e)
+	x3.Sub(x3, new(big.Int).Lsh(d, 1))
+	x3.Mod(x3, secp256k1P)
+	y3 := new(big.Int).Sub(d, x3)
+	y3.Mul(y3, e)
+	y3.Sub(y3, c.Lsh(c, 3))
+	y3.Mod(y3, secp256k1P)
+	z3 := new(big.Int).Mul(p.y, p.z)
+	z3.Lsh(z3, 1)
+	z3.Mod(z3, secp256k1P)
+	return ecJacobianPoint{x3, y3, z3}
+}
+
+// ecJacobianAddAffine adds the affine point q to p
+func ecJacobianAddAffine(p ecJacobianPoint, q ecPoint) ecJacobianPoint {
+	if p.isInfinity() {
+		return ecJacobianPoint{new(big.Int).Set(q.x), new(big.Int).Set(q.y), big.NewInt(1)}
+	}
+	// u2 = qx * z^2, s2 = qy * z^3, h = u2 - x, r = s2 - y
+	zz := new(big.Int).Mul(p.z, p.z)
+	zz.Mod(zz, secp256k1P)
+	h := new(big.Int).Mul(q.x, zz)
+	h.Sub(h, p.x)
+	h.Mod(h, secp256k1P)
+	r := new(big.Int).Mul(q.y, zz)
+	r.Mul(r, p.z)
+	r.Sub(r, p.y)
+	r.Mod(r, secp256k1P)
+	if h.Sign() == 0 {
+		if r.Sign() == 0 {
+			return ecJacobianDouble(p)
+		}
+		return ecJacobianPoint{new(big.Int), new(big.Int), new(big.Int)}
+	}
+	// x3 = r^2 - h^3 - 2x h^2, y3 = r(x h^2 - x3) - y h^3, z3 = z h
+	hh := new(big.Int).Mul(h, h)
+	hh.Mod(hh, secp256k1P)
+	hhh := new(big.Int).Mul(hh, h)
+	hhh.Mod(hhh, secp256k1P)
+	v := new(big.Int).Mul(p.x, hh)
+	v.Mod(v, secp256k1P)
+	x3 := new(big.Int).Mul(r, r)
+	x3.Sub(x3, hhh)
+	x3.Sub(x3, new(big.Int).Lsh(v, 1))
+	x3.Mod(x3, secp256k1P)
+	y3 := new(big.Int).Sub(v, x3)
+	y3.Mul(y3, r)
+	y3.Sub(y3, hhh.Mul(hhh, p.y))
+	y3.Mod(y3, secp256k1P)
+	z3 := new(big.Int).Mul(p.z, h)
+	z3.Mod(z3, secp256k1P)
+	return ecJacobianPoint{x3, y3, z3}
+}
+
+func (p ecJacobianPoint) toAffine() ecPoint {
+	if p.isInfinity() {
+		return ecPoint{}
+	}
+	zInv := new(big.Int).ModInverse(p.z, secp256k1P)
+	zInv2 := new(big.Int).Mul(zInv, zInv)
+	zInv2.Mod(zInv2, secp256k1P)
+	x := new(big.Int).Mul(p.x, zInv2)
+	x.Mod(x, secp256k1P)
+	y := new(big.Int).Mul(p.y, zInv2)
+	y.Mul(y, zInv)
+	y.Mod(y, secp256k1P)
+	return ecPoint{x, y}
+}
+
+// ecScalarBaseMul sums the precomputed points of the bits of k, with a single inverse at the end
+func ecScalarBaseMul(k *big.Int) ecPoint {
+	ecBaseTableOnce.Do(initEcBaseTable)
+	result := ecJacobianPoint{new(big.Int), new(big.Int), new(big.Int)}
+	for w := 0; w*4 < k.BitLen() && w < len(ecBaseTable); w++ {
+		j := k.Bit(w*4) | k.Bit(w*4+1)<<1 | k.Bit(w*4+2)<<2 | k.Bit(w*4+3)<<3
+		if j != 0 {
+			result = ecJacobianAddAffine(result, ecBaseTable[w][j])
+		}
+	}
+	return result.toAffine()
+}
+
+func ecPointFromCompressed(pubKey []byte) (ecPoint, error) {
+	if len(pubKey) != 33 || (pubKey[0] != 0x02 && pubKey[0] != 0x03) {
+		return ecPoint{}, errors.New("invalid compressed public key")
+	}
+	x := new(big.Int).SetBytes(pubKey[1:])
+	if x.Cmp(secp256k1P) >= 0 {
+		return ecPoint{}, errors.New("invalid public key x coordinate")
+	}
+	// y^2 = x^3 + 7
+	y2 := new(big.Int).Exp(x, big.NewInt(3), secp256k1P)
+	y2.Add(y2, big.NewInt(7))
+	y2.Mod(y2, secp256k1P)
+	y := new(big.Int).ModSqrt(y2, secp256k1P)
+	if y == nil {
+		return ecPoint{}, errors.New("public key is not on curve")
+	}
+	if y.Bit(0) != uint(pubKey[0]&0x01) {
+		y.Sub(secp256k1P, y)
+	}
+	return ecPoint{x, y}, nil
+}
+
+func ecPointToCompressed(p ecPoint) []byte {
+	pubKey := make([]byte, 33)
+	pubKey[0] = 0x02 | byte(p.y.Bit(0))
+	xBytes := p.x.Bytes()
+	copy(pubKey[33-len(xBytes):], xBytes)
+	return pubKey
+}
+
+func taggedHash(tag string, data []byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// taprootOutputKey tweaks the internal key as a key path only output (BIP86), returns the x-only output key
+func taprootOutputKey(pubKey []byte) ([]byte, error) {
+	p, err := ecPointFromCompressed(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if p.y.Bit(0) == 1 {
+		p.y = new(big.Int).Sub(secp256k1P, p.y)
+	}
+	t := new(big.Int).SetBytes(taggedHash("TapTweak", pubKey[1:]))
+	if t.Cmp(secp256k1N) >= 0 {
+		return nil, errors.New("invalid taproot tweak")
+	}
+	q := ecAdd(p, ecScalarBaseMul(t))
+	if q.isInfinity() {
+		return nil, errors.New("invalid taproot output key")
+	}
+	return ecPointToCompressed(q)[1:], nil
+}
+
+// ExtendedPubKey is a BIP32 extended public key
+type ExtendedPubKey struct {
+	Version           [4]byte
+	Depth             byte
+	ParentFingerprint [4]byte
+	ChildNumber       uint32
+	ChainCode         [32]byte
+	PubKey            [33]byte
+}
+
+func parseExtendedPubKey(keyStr string) (*ExtendedPubKey, error) {
+	keyBytes, err := base58.Decode(keyStr)
+	if err != nil {
+		return nil, err
+	}
+	if len(keyBytes) != 82 {
+		return nil, errors.New("invalid extended public key size")
+	}
+	checkSum := utility.Sha256(utility.Sha256(keyBytes[0:78]))[0:4]
+	if !bytes.Equal(checkSum, keyBytes[78:82]) {
+		return nil, errors.New("invalid extended public key checksum")
+	}
+	extPubKey := new(ExtendedPubKey)
+	copy(extPubKey.Version[0:], keyBytes[0:4])
+	extPubKey.Depth = keyBytes[4]
+	copy(extPubKey.ParentFingerprint[0:], keyBytes[5:9])
+	extPubKey.ChildNumber = binary.BigEndian.Uint32(keyBytes[9:13])
+	copy(extPubKey.ChainCode[0:], keyBytes[13:45])
+	copy(extPubKey.PubKey[0:], keyBytes[45:78])
+	_, err = ecPointFromCompressed(extPubKey.PubKey[0:])
+	if err != nil {
+		return nil, err
+	}
+	return extPubKey, nil
+}
+
+func (e *ExtendedPubKey) String() string {
+	keyBytes := make([]byte, 0, 82)
+	keyBytes = append(keyBytes, e.Version[0:]...)
+	keyBytes = append(keyBytes, e.Depth)
+	keyBytes = append(keyBytes, e.ParentFingerprint[0:]...)
+	childNumber := make([]byte, 4)
+	binary.BigEndian.PutUint32(childNumber, e.ChildNumber)
+	keyBytes = append(keyBytes, childNumber...)
+	keyBytes = append(keyBytes, e.ChainCode[0:]...)
+	keyBytes = append(keyBytes, e.PubKey[0:]...)
+	keyBytes = append(keyBytes, utility.Sha256(utility.Sha256(keyBytes))[0:4]...)
+	return base58.Encode(keyBytes)
+}
+
+// Child derives the non-hardened child public key (BIP32 CKDpub)
+func (e *ExtendedPubKey) Child(index uint32) (*ExtendedPubKey, error) {
+	if index >= HardenedKeyStart {
+		return nil, errors.New("can not derive hardened child from public key")
+	}
+	data := make([]byte, 37)
+	copy(data[0:33], e.PubKey[0:])
+	binary.BigEndian.PutUint32(data[33:], index)
+	mac := hmac.New(sha512.New, e.ChainCode[0:])
+	mac.Write(data)
+	i := mac.Sum(nil)
+
+	tweak := new(big.Int).SetBytes(i[0:32])
+	if tweak.Cmp(secp256k1N) >= 0 {
+		return nil, errors.New("invalid child key, try the next index")
+	}
+	parent, err := ecPointFromCompressed(e.PubKey[0:])
+	if err != nil {
+		return nil, err
+	}
+	child := ecAdd(ecScalarBaseMul(tweak), parent)
+	if child.isInfinity() {
+		return nil, errors.New("invalid child key, try the next index")
+	}
+
+	extPubKey := new(ExtendedPubKey)
+	extPubKey.Version = e.Version
+	extPubKey.Depth = e.Depth + 1
+	copy(extPubKey.ParentFingerprint[0:], utility.Hash160(e.PubKey[0:])[0:4])
+	extPubKey.ChildNumber = index
+	copy(extPubKey.ChainCode[0:], i[32:])
+	copy(extPubKey.PubKey[0:], ecPointToCompressed(child))
+	return extPubKey, nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+// the non-hardened steps of the BIP32 test vectors, derived from the public key of the parent
+func TestExtendedPubKeyChild(t *testing.T) {
+	for _, vector := range []struct {
+		parent string
+		index  uint32
+		child  string
+	}{
+		// test vector 1, m/0H -> m/0H/1
+		{"xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw", 1,
+			"xpub6ASuArnXKPbfEwhqN6e3mwBcDTgzisQN1wXN9BJcM47sSikHjJf3UFHKkNAWbWMiGj7Wf5uMash7SyYq527Hqck2AxYysAA7xmALppuCkwQ"},
+		// test vector 1, m/0H/1/2H -> m/0H/1/2H/2
+		{"xpub6D4BDPcP2GT577Vvch3R8wDkScZWzQzMMUm3PWbmWvVJrZwQY4VUNgqFJPMM3No2dFDFGTsxxpG5uJh7n7epu4trkrX7x7DogT5Uv6fcLW5", 2,
+			"xpub6FHa3pjLCk84BayeJxFW2SP4XRrFd1JYnxeLeU8EqN3vDfZmbqBqaGJAyiLjTAwm6ZLRQUMv1ZACTj37sR62cfN7fe5JnJ7dh8zL4fiyLHV"},
+		// test vector 1, m/0H/1/2H/2 -> m/0H/1/2H/2/1000000000
+		{"xpub6FHa3pjLCk84BayeJxFW2SP4XRrFd1JYnxeLeU8EqN3vDfZmbqBqaGJAyiLjTAwm6ZLRQUMv1ZACTj37sR62cfN7fe5JnJ7dh8zL4fiyLHV", 1000000000,
+			"xpub6H1LXWLaKsWFhvm6RVpEL9P4KfRZSW7abD2ttkWP3SSQvnyA8FSVqNTEcYFgJS2UaFcxupHiYkro49S8yGasTvXEYBVPamhGW6cFJodrTHy"},
+		// test vector 2, m -> m/0
+		{"xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB", 0,
+			"xpub69H7F5d8KSRgmmdJg2KhpAK8SR3DjMwAdkxj3ZuxV27CprR9LgpeyGmXUbC6wb7ERfvrnKZjXoUmmDznezpbZb7ap6r1D3tgFxHmwMkQTPH"},
+	} {
+		parent, err := parseExtendedPubKey(vector.parent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parent.String() != vector.parent {
+			t.Fatalf("extended public key %s, expected %s", parent.String(), vector.parent)
+		}
+		child, err := parent.Child(vector.index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if child.String() != vector.child {
+			t.Fatalf("child %d of %s is %s, expected %s", vector.index, vector.parent, child.String(), vector.child)
+		}
+	}
+}
+
+func TestExtendedPubKeyInvalid(t *testing.T) {
+	parent, err := parseExtendedPubKey("xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = parent.Child(HardenedKeyStart)
+	if err == nil {
+		t.Fatal("hardened child derived from public key")
+	}
+	// the last character changes the checksum
+	_, err = parseExtendedPubKey("xpub661MyMwAqRbcFW31YEwpkMuc5THy2PSt5bDMsktWQcFF8syAmRUapSCGu8ED9W6oDMSgv6Zz8idoc4a6mr8BDzTJY47LJhkJ8UB7WEGuduC")
+	if err == nil {
+		t.Fatal("invalid checksum accepted")
+	}
+}
+
+// the first receive address of BIP86
+func TestTaprootOutputKey(t *testing.T) {
+	internalKey, _ := hex.DecodeString("02cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115")
+	outputKey, err := taprootOutputKey(internalKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c"
+	if hex.EncodeToString(outputKey) != expected {
+		t.Fatalf("output key %x, expected %s", outputKey, expected)
+	}
+	addrStr, err := getPubKeyAddress(internalKey, XpubScriptTypeP2TR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addrStr != "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr" {
+		t.Fatalf("address %s", addrStr)
+	}
+}
+
+// the precomputed jacobian sums against the affine double and add
+func TestEcScalarBaseMul(t *testing.T) {
+	nMinusOne := new(big.Int).Sub(secp256k1N, big.NewInt(1))
+	for _, k := range []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(0xffff), nMinusOne} {
+		expected := ecPoint{}
+		addend := ecPoint{secp256k1Gx, secp256k1Gy}
+		for i := 0; i < k.BitLen(); i++ {
+			if k.Bit(i) == 1 {
+				expected = ecAdd(expected, addend)
+			}
+			addend = ecAdd(addend, addend)
+		}
+		p := ecScalarBaseMul(k)
+		if p.x.Cmp(expected.x) != 0 || p.y.Cmp(expected.y) != 0 {
+			t.Fatalf("k %x: point %x %x, expected %x %x", k, p.x, p.y, expected.x, expected.y)
+		}
+	}
+	if !ecScalarBaseMul(new(big.Int)).isInfinity() {
+		t.Fatal("zero is not the infinity")
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+)
+
+const (
+	// version 2 indexes the witness v1+ (taproot) outputs by address
+	IndexVersion          = 2
+	IndexVersionNoTaproot = 1
+)
+
+// the index version of the db, the outputs are indexed by the features of this version
+var indexVersion uint32
+
+func getIndexVersion() (uint32, error) {
+	indexVersionStr, err := globalConfigDBMgr.DBGet("indexVersion")
+	if err != nil {
+		if err.Error() != NotFoundError {
+			return 0, err
+		}
+		// the dbs indexed before the index version is recorded
+		blockHeight, err := getStartBlockHeight()
+		if err != nil {
+			return 0, err
+		}
+		if blockHeight != 0 {
+			return IndexVersionNoTaproot, nil
+		}
+		return IndexVersion, nil
+	}
+	ui64, err := strconv.ParseUint(indexVersionStr, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(ui64), nil
+}
+
+func storeIndexVersion(version uint32) error {
+	err := globalConfigDBMgr.DBPut("indexVersion", strconv.Itoa(int(version)))
+	if err != nil {
+		return err
+	}
+	atomic.StoreUint32(&indexVersion, version)
+	return nil
+}
+
+func loadIndexVersion() error {
+	version, err := getIndexVersion()
+	if err != nil {
+		return err
+	}
+	atomic.StoreUint32(&indexVersion, version)
+	if version < IndexVersion {
+		fmt.Println("index version", version, "is older than", IndexVersion, ", the taproot outputs are not indexed by address, reindex from 0 to index them")
+	}
+	return nil
+}
+
+func isTaprootIndexed() bool {
+	return atomic.LoadUint32(&indexVersion) >= IndexVersion
+}
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	InsightPathPrefix     = "/insight-api"
+	InsightTrxIdsCountMax = 1000
+)
+
+type InsightAddressPrintAble struct {
+	AddrStr                 string   `json:"addrStr"`
+	Balance                 float64  `json:"balance"`
+	BalanceSat              int64    `json:"balanceSat"`
+	TotalReceived           float64  `json:"totalReceived"`
+	TotalReceivedSat        int64    `json:"totalReceivedSat"`
+	TotalSent               float64  `json:"totalSent"`
+	TotalSentSat            int64    `json:"totalSentSat"`
+	UnconfirmedBalance      float64  `json:"unconfirmedBalance"`
+	UnconfirmedBalanceSat   int64    `json:"unconfirmedBalanceSat"`
+	UnconfirmedTxApperances uint32   `json:"unconfirmedTxApperances"`
+	TxApperances            uint32   `json:"txApperances"`
+	Transactions            []string `json:"transactions,omitempty"`
+}
+
+type InsightUtxoPrintAble struct {
+	Address       string  `json:"address"`
+	TrxId         string  `json:"txid"`
+	Vout          uint32  `json:"vout"`
+	ScriptPubKey  string  `json:"scriptPubKey"`
+	Amount        float64 `json:"amount"`
+	Satoshis      int64   `json:"satoshis"`
+	Height        uint32  `json:"height"`
+	Confirmations uint32  `json:"confirmations"`
+}
+
+type InsightScriptSig struct {
+	Hex string `json:"hex"`
+}
+
+type InsightTxIn struct {
+	TrxId     string            `json:"txid,omitempty"`
+	Vout      uint32            `json:"vout"`
+	CoinBase  string            `json:"coinbase,omitempty"`
+	Sequence  uint32            `json:"sequence"`
+	N         int               `json:"n"`
+	ScriptSig *InsightScriptSig `json:"scriptSig,omitempty"`
+	Addr      string            `json:"addr,omitempty"`
+	ValueSat  int64             `json:"valueSat"`
+	Value     float64           `json:"value"`
+}
+
+type InsightScriptPubKey struct {
+	Hex       string   `json:"hex"`
+	Addresses []string `json:"addresses,omitempty"`
+	Type      string   `json:"type"`
+}
+
+type InsightTxOut struct {
+	Value        string              `json:"value"`
+	N            int                 `json:"n"`
+	ScriptPubKey InsightScriptPubKey `json:"scriptPubKey"`
+}
+
+type InsightTrxPrintAble struct {
+	TrxId         string         `json:"txid"`
+	Version       int32          `json:"version"`
+	LockTime      uint32         `json:"locktime"`
+	Vin           []InsightTxIn  `json:"vin"`
+	Vout          []InsightTxOut `json:"vout"`
+	BlockHash     string         `json:"blockhash,omitempty"`
+	BlockHeight   int64          `json:"blockheight"`
+	Confirmations uint32         `json:"confirmations"`
+	Time          uint32         `json:"time,omitempty"`
+	BlockTime     uint32         `json:"blocktime,omitempty"`
+	IsCoinBase    bool           `json:"isCoinBase,omitempty"`
+	ValueOut      float64        `json:"valueOut"`
+	Size          int            `json:"size"`
+	ValueIn       float64        `json:"valueIn,omitempty"`
+	Fees          float64        `json:"fees,omitempty"`
+}
+
+type InsightSendTrxArgs struct {
+	RawTx string `json:"rawtx"`
+}
+
+type InsightSendTrxPrintAble struct {
+	TrxId string `json:"txid"`
+}
+
+func satoshiToBtc(value int64) float64 {
+	return float64(value) / 100000000
+}
+
+func writeInsightJson(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeInsightError(w http.ResponseWriter, status int, text string) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(text))
+}
+
+func getInsightAddress(addrStr string, from int, to int, isTxListIncluded bool) (InsightAddressPrintAble, error) {
+	var insightAddr InsightAddressPrintAble
+	insightAddr.AddrStr = addrStr
+	trxSeqs, err := getAddressTrxSeqs(addrStr)
+	if err != nil {
+		return InsightAddressPrintAble{}, err
+	}
+	utxos, err := getAddressUtxos(addrStr, trxSeqs)
+	if err != nil {
+		return InsightAddressPrintAble{}, err
+	}
+	for _, utxo := range utxos {
+		insightAddr.BalanceSat += utxo.Amount
+	}
+	insightAddr.Balance = satoshiToBtc(insightAddr.BalanceSat)
+	// the received and sent sums need the trx undo, which is missing below the snapshot height
+	stats, err := getAddressTxoStats(addrStr, trxSeqs)
+	if err == nil {
+		insightAddr.TotalReceivedSat = stats.FundedTxoSum
+		insightAddr.TotalSentSat = stats.SpentTxoSum
+		insightAddr.TotalReceived = satoshiToBtc(insightAddr.TotalReceivedSat)
+		insightAddr.TotalSent = satoshiToBtc(insightAddr.TotalSentSat)
+	}
+	insightAddr.TxApperances = uint32(len(trxSeqs))
+	if !isTxListIncluded {
+		return insightAddr, nil
+	}
+
+	// the newest first
+	insightAddr.Transactions = []string{}
+	for i := len(trxSeqs) - 1 - from; i >= 0 && i > len(trxSeqs)-1-to; i-- {
+		trxId, err := trxSeqDBMgr.DBGet(trxSeqs[i])
+		if err != nil {
+			continue
+		}
+		insightAddr.Transactions = append(insightAddr.Transactions, trxId.GetHex())
+	}
+	return insightAddr, nil
+}
+
+func getInsightTrx(trxIdStr string) (InsightTrxPrintAble, error) {
+	var trxVerbose TrxVerbosePrintAble
+	err := new(Service).GetTrxVerbose(nil, &trxIdStr, &trxVerbose)
+	if err != nil {
+		return InsightTrxPrintAble{}, err
+	}
+	var insightTrx InsightTrxPrintAble
+	insightTrx.TrxId = trxVerbose.TrxId
+	insightTrx.Version = trxVerbose.Version
+	insightTrx.LockTime = trxVerbose.LockTime
+	insightTrx.Size = trxVerbose.Size
+	insightTrx.IsCoinBase = len(trxVerbose.Vin) == 1 && trxVerbose.Vin[0].PrevOut.Hash == ""
+
+	var valueIn int64
+	insightTrx.Vin = make([]InsightTxIn, 0, len(trxVerbose.Vin))
+	for i, vin := range trxVerbose.Vin {
+		var txIn InsightTxIn
+		txIn.N = i
+		txIn.Sequence = vin.Sequence
+		if insightTrx.IsCoinBase {
+			txIn.CoinBase = vin.ScriptSig
+		} else {
+			txIn.TrxId = vin.PrevOut.Hash
+			txIn.Vout = vin.PrevOut.N
+			txIn.ScriptSig = &InsightScriptSig{vin.ScriptSig}
+			txIn.Addr = vin.Address
+			txIn.ValueSat = vin.Value
+			txIn.Value = satoshiToBtc(vin.Value)
+			valueIn += vin.Value
+		}
+		insightTrx.Vin = append(insightTrx.Vin, txIn)
+	}
+	var valueOut int64
+	insightTrx.Vout = make([]InsightTxOut, 0, len(trxVerbose.Vout))
+	for i, vout := range trxVerbose.Vout {
+		var txOut InsightTxOut
+		txOut.Value = strconv.FormatFloat(satoshiToBtc(vout.Value), 'f', 8, 64)
+		txOut.N = i
+		txOut.ScriptPubKey.Hex = vout.ScriptPubKey
+		txOut.ScriptPubKey.Type = vout.ScriptType
+		if vout.Address != "" {
+			txOut.ScriptPubKey.Addresses = strings.Split(vout.Address, ",")
+		}
+		insightTrx.Vout = append(insightTrx.Vout, txOut)
+		valueOut += vout.Value
+	}
+	insightTrx.ValueOut = satoshiToBtc(valueOut)
+	if !insightTrx.IsCoinBase {
+		insightTrx.ValueIn = satoshiToBtc(valueIn)
+		insightTrx.Fees = satoshiToBtc(trxVerbose.Fee)
+	}
+
+	// unconfirmed trxs are not indexed, blockheight -1 as insight does
+	insightTrx.BlockHeight = -1
+	if trxVerbose.Status.BlockHash != "" {
+		insightTrx.BlockHash = trxVerbose.Status.BlockHash
+		insightTrx.BlockHeight = int64(trxVerbose.Status.BlockHeight)
+		insightTrx.Confirmations = trxVerbose.Status.Confirmations
+		blockInfo, err := blockDBMgr.DBGet(trxVerbose.Status.BlockHeight)
+		if err == nil {
+			insightTrx.Time = blockInfo.Header.Time
+			insightTrx.BlockTime = blockInfo.Header.Time
+		}
+	}
+	return insightTrx, nil
+}
+
+func getInsightUtxos(addresses []string) ([]InsightUtxoPrintAble, error) {
+	var addressesUtxos []AddressUtxosPrintAble
+	err := new(Service).ListUnSpentMulti(nil, &addresses, &addressesUtxos)
+	if err != nil {
+		return nil, err
+	}
+	insightUtxos := []InsightUtxoPrintAble{}
+	flushedHeight := getFlushedBlockHeight()
+	for _, addressUtxos := range addressesUtxos {
+		for _, utxo := range addressUtxos.Utxos {
+			var insightUtxo InsightUtxoPrintAble
+			insightUtxo.Address = addressUtxos.Address
+			insightUtxo.TrxId = utxo.TrxId
+			insightUtxo.Vout = utxo.Vout
+			insightUtxo.ScriptPubKey = utxo.ScriptPubKey
+			insightUtxo.Amount = satoshiToBtc(utxo.Amount)
+			insightUtxo.Satoshis = utxo.Amount
+			insightUtxo.Height = utxo.BlockHeight
+			if flushedHeight >= utxo.BlockHeight {
+				insightUtxo.Confirmations = flushedHeight - utxo.BlockHeight + 1
+			}
+			insightUtxos = append(insightUtxos, insightUtxo)
+		}
+	}
+	return insightUtxos, nil
+}
+
+func insightGetAddress(w http.ResponseWriter, r *http.Request) {
+	addrStr := mux.Vars(r)["addr"]
+	_, err := getScriptFromAddress(addrStr)
+	if err != nil {
+		writeInsightError(w, http.StatusBadRequest, "Invalid address: "+err.Error()+". Code:1")
+		return
+	}
+	query := r.URL.Query()
+	from, _ := strconv.Atoi(query.Get("from"))
+	to, err := strconv.Atoi(query.Get("to"))
+	if err != nil {
+		to = from + InsightTrxIdsCountMax
+	}
+	if from < 0 || to < from || to-from > InsightTrxIdsCountMax {
+		writeInsightError(w, http.StatusBadRequest, "Invalid from/to range, max: "+strconv.Itoa(InsightTrxIdsCountMax))
+		return
+	}
+	insightAddr, err := getInsightAddress(addrStr, from, to, query.Get("noTxList") != "1")
+	if err != nil {
+		writeInsightError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeInsightJson(w, insightAddr)
+}
+
+func insightGetAddressesUtxos(w http.ResponseWriter, r *http.Request) {
+	addresses := strings.Split(mux.Vars(r)["addrs"], ",")
+	for _, addrStr := range addresses {
+		_, err := getScriptFromAddress(addrStr)
+		if err != nil {
+			writeInsightError(w, http.StatusBadRequest, "Invalid address: "+addrStr+". Code:1")
+			return
+		}
+	}
+	insightUtxos, err := getInsightUtxos(addresses)
+	if err != nil {
+		writeInsightError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeInsightJson(w, insightUtxos)
+}
+
+func insightGetTrx(w http.ResponseWriter, r *http.Request) {
+	trxIdStr := mux.Vars(r)["txid"]
+	if len(trxIdStr) != 64 {
+		writeInsightError(w, http.StatusBadRequest, "Invalid transaction id")
+		return
+	}
+	insightTrx, err := getInsightTrx(trxIdStr)
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			writeInsightError(w, http.StatusNotFound, "Not found")
+			return
+		}
+		writeInsightError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeInsightJson(w, insightTrx)
+}
+
+// insightSendTrx accepts both the json body and the form body of insight
+func insightSendTrx(w http.ResponseWriter, r *http.Request) {
+	var args InsightSendTrxArgs
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, EsploraPostTrxSizeMax*2+64))
+		if err == nil {
+			err = json.Unmarshal(body, &args)
+		}
+		if err != nil {
+			writeInsightError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	} else {
+		args.RawTx = r.FormValue("rawtx")
+	}
+	if args.RawTx == "" {
+		writeInsightError(w, http.StatusBadRequest, errors.New("missing rawtx").Error())
+		return
+	}
+	result := sendRawTrx(strings.TrimSpace(args.RawTx))
+	if !result.Accepted {
+		writeInsightError(w, http.StatusBadRequest, result.RejectCode+": "+result.RejectReason+". Code:-25")
+		return
+	}
+	writeInsightJson(w, InsightSendTrxPrintAble{result.TrxId})
+}
+
+func registerInsightHandlers(urlRouter *mux.Router) {
+	insightRouter := urlRouter.PathPrefix(InsightPathPrefix).Subrouter()
+	insightRouter.HandleFunc("/addr/{addr}", insightGetAddress).Methods("GET")
+	insightRouter.HandleFunc("/addrs/{addrs}/utxo", insightGetAddressesUtxos).Methods("GET")
+	insightRouter.HandleFunc("/tx/send", insightSendTrx).Methods("POST")
+	insightRouter.HandleFunc("/tx/{txid}", insightGetTrx).Methods("GET")
+}
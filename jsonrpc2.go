@@ -0,0 +1,342 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	JsonRpc2Version     = "2.0"
+	JsonRpc2BodySizeMax = 8 * 1024 * 1024
+	JsonRpc2BatchMax    = 100
+)
+
+// error codes of the json rpc 2.0 specification
+const (
+	JsonRpc2ParseError     = -32700
+	JsonRpc2InvalidRequest = -32600
+	JsonRpc2MethodNotFound = -32601
+	JsonRpc2InvalidParams  = -32602
+	JsonRpc2ServerError    = -32000
+	JsonRpc2Forbidden      = -32001
+	JsonRpc2RateLimited    = -32002
+)
+
+type JsonRpc2Request struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	Id      json.RawMessage `json:"id"`
+}
+
+type JsonRpc2Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+type JsonRpc2RateLimitedData struct {
+	RetryAfterMs int64 `json:"retryAfterMs"`
+}
+
+type JsonRpc2Response struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *JsonRpc2Error  `json:"error,omitempty"`
+	Id      json.RawMessage `json:"id"`
+}
+
+// jsonRpc2Response marshals "result": null on success, which omitempty of Result would drop
+type jsonRpc2Response struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result"`
+	Id      json.RawMessage `json:"id"`
+}
+
+func (j JsonRpc2Response) MarshalJSON() ([]byte, error) {
+	if j.Error != nil {
+		type jsonRpc2ErrorResponse JsonRpc2Response
+		return json.Marshal(jsonRpc2ErrorResponse(j))
+	}
+	return json.Marshal(jsonRpc2Response{j.JsonRpc, j.Result, j.Id})
+}
+
+type jsonRpc2Method struct {
+	method    reflect.Method
+	argsType  reflect.Type
+	replyType reflect.Type
+}
+
+// JsonRpc2Handler serves the json rpc 2.0 requests with the methods of Service,
+// the json rpc 1.0 requests are passed to the gorilla rpc server
+type JsonRpc2Handler struct {
+	service     reflect.Value
+	methods     map[string]*jsonRpc2Method
+	nextHandler http.Handler
+}
+
+var typeOfHttpRequest = reflect.TypeOf((*http.Request)(nil))
+var typeOfError = reflect.TypeOf((*error)(nil)).Elem()
+
+// newJsonRpc2Handler registers the methods as "Service.Method" and the lower case alias "method"
+func newJsonRpc2Handler(service *Service, nextHandler http.Handler) *JsonRpc2Handler {
+	handler := new(JsonRpc2Handler)
+	handler.service = reflect.ValueOf(service)
+	handler.methods = make(map[string]*jsonRpc2Method)
+	handler.nextHandler = nextHandler
+	serviceType := reflect.TypeOf(service)
+	serviceName := serviceType.Elem().Name()
+	for i := 0; i < serviceType.NumMethod(); i++ {
+		method := serviceType.Method(i)
+		methodType := method.Type
+		if methodType.NumIn() != 4 || methodType.NumOut() != 1 || methodType.Out(0) != typeOfError {
+			continue
+		}
+		if methodType.In(1) != typeOfHttpRequest || methodType.In(2).Kind() != reflect.Ptr || methodType.In(3).Kind() != reflect.Ptr {
+			continue
+		}
+		rpcMethod := &jsonRpc2Method{method, methodType.In(2).Elem(), methodType.In(3).Elem()}
+		handler.methods[serviceName+"."+method.Name] = rpcMethod
+		handler.methods[strings.ToLower(method.Name)] = rpcMethod
+	}
+	return handler
+}
+
+func newJsonRpc2ErrorResponse(id json.RawMessage, code int, message string) JsonRpc2Response {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return JsonRpc2Response{JsonRpc2Version, nil, &JsonRpc2Error{code, message, nil}, id}
+}
+
+// decodeJsonRpc2Params takes the first element of positional params like the gorilla json codec,
+// named params fill the fields of a struct argument, or the only argument by its single name
+func decodeJsonRpc2Params(params json.RawMessage, argsType reflect.Type) (reflect.Value, error) {
+	args := reflect.New(argsType)
+	params = bytes.TrimSpace(params)
+	if len(params) == 0 || string(params) == "null" {
+		return args, nil
+	}
+	if params[0] == '[' {
+		var positionalParams []json.RawMessage
+		err := json.Unmarshal(params, &positionalParams)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(positionalParams) == 0 {
+			return args, nil
+		}
+		if len(positionalParams) > 1 {
+			return reflect.Value{}, errors.New("too many positional params, max: 1")
+		}
+		err = json.Unmarshal(positionalParams[0], args.Interface())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return args, nil
+	}
+	if params[0] != '{' {
+		return reflect.Value{}, errors.New("params must be an array or an object")
+	}
+	_, isUnmarshaler := args.Interface().(json.Unmarshaler)
+	if argsType.Kind() == reflect.Struct || isUnmarshaler {
+		err := json.Unmarshal(params, args.Interface())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return args, nil
+	}
+	var namedParams map[string]json.RawMessage
+	err := json.Unmarshal(params, &namedParams)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if len(namedParams) > 1 {
+		return reflect.Value{}, errors.New("too many named params, max: 1")
+	}
+	for _, param := range namedParams {
+		err = json.Unmarshal(param, args.Interface())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+	}
+	return args, nil
+}
+
+// callJsonRpc2 returns nil for a notification, which has no id
+func (j *JsonRpc2Handler) callJsonRpc2(r *http.Request, request *JsonRpc2Request) *JsonRpc2Response {
+	var response JsonRpc2Response
+	if request.JsonRpc != JsonRpc2Version || request.Method == "" {
+		response = newJsonRpc2ErrorResponse(request.Id, JsonRpc2InvalidRequest, "invalid request")
+		return &response
+	}
+	rpcMethod, ok := j.methods[request.Method]
+	if !ok {
+		if request.Id == nil {
+			return nil
+		}
+		response = newJsonRpc2ErrorResponse(request.Id, JsonRpc2MethodNotFound, "method not found: "+request.Method)
+		return &response
+	}
+	if !getAuthPrincipal(r).HasRole(getMethodRole(rpcMethod.method.Name)) {
+		if request.Id == nil {
+			return nil
+		}
+		response = newJsonRpc2ErrorResponse(request.Id, JsonRpc2Forbidden, "forbidden")
+		return &response
+	}
+	ok, retryAfter := takeHttpRateLimit(r, rpcMethod.method.Name)
+	if !ok {
+		if request.Id == nil {
+			return nil
+		}
+		response = newJsonRpc2ErrorResponse(request.Id, JsonRpc2RateLimited, getRetryAfterMessage(retryAfter))
+		response.Error.Data = JsonRpc2RateLimitedData{retryAfter.Milliseconds()}
+		return &response
+	}
+	args, err := decodeJsonRpc2Params(request.Params, rpcMethod.argsType)
+	if err != nil {
+		if request.Id == nil {
+			return nil
+		}
+		response = newJsonRpc2ErrorResponse(request.Id, JsonRpc2InvalidParams, "invalid params: "+err.Error())
+		return &response
+	}
+	reply := reflect.New(rpcMethod.replyType)
+	startTime := time.Now()
+	errValue := rpcMethod.method.Func.Call([]reflect.Value{j.service, reflect.ValueOf(r), args, reply})
+	var errResult error
+	if errInter := errValue[0].Interface(); errInter != nil {
+		errResult = errInter.(error)
+	}
+	metrics.ObserveRpc("jsonrpc", rpcMethod.method.Name, startTime, errResult)
+	if request.Id == nil {
+		return nil
+	}
+	if errResult != nil {
+		response = newJsonRpc2ErrorResponse(request.Id, JsonRpc2ServerError, errResult.Error())
+		return &response
+	}
+	response = JsonRpc2Response{JsonRpc2Version, reply.Elem().Interface(), nil, request.Id}
+	return &response
+}
+
+func writeJsonRpc2(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (j *JsonRpc2Handler) serveJsonRpc2Batch(w http.ResponseWriter, r *http.Request, body []byte) {
+	var batch []json.RawMessage
+	err := json.Unmarshal(body, &batch)
+	if err != nil {
+		writeJsonRpc2(w, http.StatusOK, newJsonRpc2ErrorResponse(nil, JsonRpc2ParseError, "parse error"))
+		return
+	}
+	if len(batch) == 0 {
+		writeJsonRpc2(w, http.StatusOK, newJsonRpc2ErrorResponse(nil, JsonRpc2InvalidRequest, "empty batch"))
+		return
+	}
+	if len(batch) > JsonRpc2BatchMax {
+		writeJsonRpc2(w, http.StatusOK, newJsonRpc2ErrorResponse(nil, JsonRpc2InvalidRequest, "too many requests in batch, max: "+strconv.Itoa(JsonRpc2BatchMax)))
+		return
+	}
+	responses := make([]*JsonRpc2Response, 0, len(batch))
+	for _, requestData := range batch {
+		var request JsonRpc2Request
+		err := json.Unmarshal(requestData, &request)
+		if err != nil {
+			response := newJsonRpc2ErrorResponse(nil, JsonRpc2InvalidRequest, "invalid request")
+			responses = append(responses, &response)
+			continue
+		}
+		response := j.callJsonRpc2(r, &request)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+	// nothing is returned for a batch of notifications
+	if len(responses) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJsonRpc2(w, http.StatusOK, responses)
+}
+
+func (j *JsonRpc2Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		j.nextHandler.ServeHTTP(w, r)
+		return
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, JsonRpc2BodySizeMax+1))
+	if err != nil {
+		http.Error(w, "rpc: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) > JsonRpc2BodySizeMax {
+		http.Error(w, "rpc: request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	bodyTrim := bytes.TrimSpace(body)
+	if len(bodyTrim) != 0 && bodyTrim[0] == '[' {
+		j.serveJsonRpc2Batch(w, r, bodyTrim)
+		return
+	}
+	var request JsonRpc2Request
+	err = json.Unmarshal(bodyTrim, &request)
+	if err != nil {
+		// the gorilla decoder ignores the trailing bytes, the body is authorized only if it is parsed as a whole
+		http.Error(w, "rpc: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if request.JsonRpc != JsonRpc2Version {
+		// json rpc 1.0
+		rpcMethod, ok := j.methods[request.Method]
+		if !ok {
+			http.Error(w, "rpc: can't find method "+request.Method, http.StatusBadRequest)
+			return
+		}
+		if !getAuthPrincipal(r).HasRole(getMethodRole(rpcMethod.method.Name)) {
+			http.Error(w, "rpc: forbidden", http.StatusForbidden)
+			return
+		}
+		isTaken, retryAfter := takeHttpRateLimit(r, rpcMethod.method.Name)
+		if !isTaken {
+			writeTooManyRequests(w, retryAfter)
+			return
+		}
+		// forward the parsed request, so that the gorilla server calls the authorized method
+		bodyRequest, err := json.Marshal(&request)
+		if err != nil {
+			http.Error(w, "rpc: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(bodyRequest))
+		j.nextHandler.ServeHTTP(w, r)
+		return
+	}
+	response := j.callJsonRpc2(r, &request)
+	if response == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	status := http.StatusOK
+	if response.Error != nil && response.Error.Code == JsonRpc2Forbidden {
+		status = http.StatusForbidden
+	}
+	if response.Error != nil && response.Error.Code == JsonRpc2RateLimited {
+		retryAfterMs := response.Error.Data.(JsonRpc2RateLimitedData).RetryAfterMs
+		w.Header().Set("Retry-After", strconv.Itoa(getRetryAfterSeconds(time.Duration(retryAfterMs)*time.Millisecond)))
+		status = http.StatusTooManyRequests
+	}
+	writeJsonRpc2(w, status, response)
+}
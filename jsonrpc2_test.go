@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodeJsonRpc2Params(t *testing.T) {
+	for _, vector := range []struct {
+		params   string
+		argsType reflect.Type
+		expected interface{}
+	}{
+		{``, reflect.TypeOf(""), ""},
+		{`null`, reflect.TypeOf(""), ""},
+		{`[]`, reflect.TypeOf(uint32(0)), uint32(0)},
+		// the first positional param like the gorilla json codec
+		{`["1abc"]`, reflect.TypeOf(""), "1abc"},
+		{` [7] `, reflect.TypeOf(uint32(0)), uint32(7)},
+		{`[{"HeightOrHash":"7","Count":2}]`, reflect.TypeOf(BlockQueryArgs{}), BlockQueryArgs{HeightOrHash: "7", Count: 2}},
+		// named params fill the struct fields, or the only argument
+		{`{"HeightOrHash":"7","Start":1}`, reflect.TypeOf(BlockQueryArgs{}), BlockQueryArgs{HeightOrHash: "7", Start: 1}},
+		{`{"address":"1abc"}`, reflect.TypeOf(""), "1abc"},
+		{`{}`, reflect.TypeOf(uint32(0)), uint32(0)},
+	} {
+		args, err := decodeJsonRpc2Params(json.RawMessage(vector.params), vector.argsType)
+		if err != nil {
+			t.Fatalf("params %s: %v", vector.params, err)
+		}
+		if !reflect.DeepEqual(args.Elem().Interface(), vector.expected) {
+			t.Fatalf("params %s: args %v, expected %v", vector.params, args.Elem().Interface(), vector.expected)
+		}
+	}
+
+	for _, vector := range []struct {
+		params   string
+		argsType reflect.Type
+	}{
+		{`["1abc","1def"]`, reflect.TypeOf("")},
+		{`{"address":"1abc","count":2}`, reflect.TypeOf("")},
+		{`"1abc"`, reflect.TypeOf("")},
+		{`[7]`, reflect.TypeOf("")},
+		{`{"HeightOrHash":7}`, reflect.TypeOf(BlockQueryArgs{})},
+	} {
+		_, err := decodeJsonRpc2Params(json.RawMessage(vector.params), vector.argsType)
+		if err == nil {
+			t.Fatalf("params %s: unexpected args", vector.params)
+		}
+	}
+}
+
+func serveJsonRpc2Test(handler http.Handler, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest("POST", "/", strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+// the json rpc 1.0 requests reach the gorilla server as the parsed request, or not at all
+func TestJsonRpc2HandlerJsonRpc1(t *testing.T) {
+	var bodyNext string
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bytesBody, _ := ioutil.ReadAll(r.Body)
+		bodyNext = string(bytesBody)
+	})
+	handler := newJsonRpc2Handler(new(Service), nextHandler)
+	for _, vector := range []struct {
+		body     string
+		status   int
+		bodyNext string
+	}{
+		{`{"method":"Service.GetBlockCount","params":[{}],"id":1}`, http.StatusOK,
+			`{"jsonrpc":"","method":"Service.GetBlockCount","params":[{}],"id":1}`},
+		// the trailing bytes are dropped with the parsed request
+		{`{"method":"Service.GetBlockCount","params":[{}],"id":1} {"method":"Service.BackupDB"}`, http.StatusBadRequest, ""},
+		{`{"method":"Service.NoSuchMethod","params":[],"id":1}`, http.StatusBadRequest, ""},
+		{`{"method":`, http.StatusBadRequest, ""},
+	} {
+		bodyNext = ""
+		recorder := serveJsonRpc2Test(handler, vector.body)
+		if recorder.Code != vector.status {
+			t.Fatalf("body %s: status %d, expected %d", vector.body, recorder.Code, vector.status)
+		}
+		if bodyNext != vector.bodyNext {
+			t.Fatalf("body %s: forwarded %s, expected %s", vector.body, bodyNext, vector.bodyNext)
+		}
+	}
+}
+
+func TestJsonRpc2HandlerBatch(t *testing.T) {
+	handler := newJsonRpc2Handler(new(Service), http.NotFoundHandler())
+	for _, vector := range []struct {
+		body      string
+		status    int
+		responses int
+	}{
+		// nothing is returned for the notifications
+		{`[{"jsonrpc":"2.0","method":"nosuchmethod"},{"jsonrpc":"2.0","method":"nosuchmethod","params":[1]}]`, http.StatusNoContent, 0},
+		{`[{"jsonrpc":"2.0","method":"nosuchmethod"},{"jsonrpc":"2.0","method":"nosuchmethod","id":2}]`, http.StatusOK, 1},
+		{`[{"jsonrpc":"2.0","method":"nosuchmethod","id":1},1,{"jsonrpc":"1.0","method":"getblockcount","id":3}]`, http.StatusOK, 3},
+	} {
+		recorder := serveJsonRpc2Test(handler, vector.body)
+		if recorder.Code != vector.status {
+			t.Fatalf("body %s: status %d, expected %d", vector.body, recorder.Code, vector.status)
+		}
+		if vector.responses == 0 {
+			if recorder.Body.Len() != 0 {
+				t.Fatalf("body %s: response %s", vector.body, recorder.Body.String())
+			}
+			continue
+		}
+		var responses []JsonRpc2Response
+		err := json.Unmarshal(recorder.Body.Bytes(), &responses)
+		if err != nil {
+			t.Fatalf("body %s: %v", vector.body, err)
+		}
+		if len(responses) != vector.responses {
+			t.Fatalf("body %s: responses %s", vector.body, recorder.Body.String())
+		}
+	}
+
+	for _, body := range []string{`[]`, `[{"jsonrpc":"2.0"`} {
+		recorder := serveJsonRpc2Test(handler, body)
+		var response JsonRpc2Response
+		err := json.Unmarshal(recorder.Body.Bytes(), &response)
+		if err != nil || response.Error == nil {
+			t.Fatalf("body %s: response %s", body, recorder.Body.String())
+		}
+	}
+}
@@ -18,9 +18,18 @@ var addrTrxsDBMgr *AddrTrxsDBMgr
 var utxoDBMgr *UtxoDBMgr
 var trxSeqDBMgr *TrxSeqDBMgr
 var rawTrxDBMgr *RawTrxDBMgr
+var trxUndoDBMgr *TrxUndoDBMgr
+var trxLocDBMgr *TrxLocDBMgr
+var blockDBMgr *BlockDBMgr
+var blockHashDBMgr *BlockHashDBMgr
+var utxoLockDBMgr *UtxoLockDBMgr
+var scriptHashDBMgr *ScriptHashDBMgr
 
 var quitFlag = false
 var quitChan chan byte
+
+// the dbs are opened read only by the verify command
+var isDBReadOnly = false
 var config Config
 
 var startBlockHeight uint32
@@ -36,6 +45,10 @@ func appInit() error {
 	slotCache = new(SlotCache)
 	slotCache.Initialize()
 
+	// init raw trx cache
+	rawTrxCache = new(RawTrxCache)
+	rawTrxCache.Initialize(config.CacheConfig.RawTrxCacheCount)
+
 	// init goroutine manager
 	goroutineMgr = new(goroutine_mgr.GoroutineManager)
 	goroutineMgr.Initialise("MainGoroutineManager")
@@ -82,6 +95,48 @@ func appInit() error {
 		return err
 	}
 
+	// init trx undo db manager
+	trxUndoDBMgr = new(TrxUndoDBMgr)
+	err = trxUndoDBMgr.DBOpen(config.DBConfig.DBDir + "/" + "trx_undo_db")
+	if err != nil {
+		return err
+	}
+
+	// init trx location db manager
+	trxLocDBMgr = new(TrxLocDBMgr)
+	err = trxLocDBMgr.DBOpen(config.DBConfig.DBDir + "/" + "trx_loc_db")
+	if err != nil {
+		return err
+	}
+
+	// init block db manager
+	blockDBMgr = new(BlockDBMgr)
+	err = blockDBMgr.DBOpen(config.DBConfig.DBDir + "/" + "block_db")
+	if err != nil {
+		return err
+	}
+
+	// init block hash db manager
+	blockHashDBMgr = new(BlockHashDBMgr)
+	err = blockHashDBMgr.DBOpen(config.DBConfig.DBDir + "/" + "block_hash_db")
+	if err != nil {
+		return err
+	}
+
+	// init utxo lock db manager
+	utxoLockDBMgr = new(UtxoLockDBMgr)
+	err = utxoLockDBMgr.DBOpen(config.DBConfig.DBDir + "/" + "utxo_lock_db")
+	if err != nil {
+		return err
+	}
+
+	// init script hash db manager
+	scriptHashDBMgr = new(ScriptHashDBMgr)
+	err = scriptHashDBMgr.DBOpen(config.DBConfig.DBDir + "/" + "script_hash_db")
+	if err != nil {
+		return err
+	}
+
 	// get chain index state
 	state, err := getChainIndexState()
 	if err != nil {
@@ -89,17 +144,60 @@ func appInit() error {
 			return err
 		}
 	} else {
-		if state != "1" {
+		// verify reads the dbs of an interrupted reindex too, the state is in its report
+		if state != "1" && !isDBReadOnly {
 			return errors.New("incorrect chain index state")
 		}
 	}
 
+	// load the block height flushed to the db
+	err = loadFlushedBlockHeight()
+	if err != nil {
+		return err
+	}
+
+	// load the network params, the network of the db should not be changed
+	err = loadNetworkParams()
+	if err != nil {
+		return err
+	}
+
+	// load the index version, the older dbs do not index the taproot addresses
+	err = loadIndexVersion()
+	if err != nil {
+		return err
+	}
+
+	// load utxo set info
+	utxoSetInfo, err = loadUtxoSetInfo()
+	if err != nil {
+		return err
+	}
+
+	// index the script hashes of the addresses indexed by older versions
+	if !isDBReadOnly {
+		err = initScriptHashIndex()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func appRun() error {
 	startSignalHandler()
+	if config.RpcServerConfig.GrpcListenEndPoint != "" {
+		_, err := startGrpcServer()
+		if err != nil {
+			return err
+		}
+	}
 	startRpcServer()
+	if isRawTrxPruneEnabled() {
+		// prune the raw trxs out of the last blocks in the background
+		startPruneRawTrx()
+	}
 
 	if config.RpcClientConfig.DataSource == "btcWallet" {
 		// collect from the wallet node
@@ -158,6 +256,34 @@ func appCmd() error {
 		} else if strLine == "memoryfree" {
 			runtime.GC()
 			debug.FreeOSMemory()
+		} else if strings.HasPrefix(strLine, "backup ") {
+			dstDir := strings.TrimSpace(strings.TrimPrefix(strLine, "backup "))
+			manifest, err := backupAllDB(dstDir)
+			if err != nil {
+				fmt.Println("backup", err)
+			} else {
+				fmt.Println("backup finished at block height:", manifest.BlockHeight)
+			}
+		} else if strLine == "verify" || strings.HasPrefix(strLine, "verify ") {
+			verifyArgs, err := parseVerifyArgs(strings.Fields(strLine)[1:])
+			if err != nil {
+				fmt.Println("verify", err)
+			} else {
+				report, err := verifyIndexDB(verifyArgs)
+				if err != nil {
+					fmt.Println("verify", err)
+				} else {
+					printVerifyReport(&report)
+				}
+			}
+		} else if strings.HasPrefix(strLine, "exportsnapshot ") {
+			fileName := strings.TrimSpace(strings.TrimPrefix(strLine, "exportsnapshot "))
+			blockHeight, err := exportSnapshot(fileName)
+			if err != nil {
+				fmt.Println("exportsnapshot", err)
+			} else {
+				fmt.Println("snapshot exported at block height:", blockHeight)
+			}
 		} else {
 			fmt.Println("not support command: ", strLine)
 		}
@@ -165,11 +291,7 @@ func appCmd() error {
 	<-quitChan
 
 	// sync and close
-	_ = globalConfigDBMgr.DBClose()
-	_ = addrTrxsDBMgr.DBClose()
-	_ = utxoDBMgr.DBClose()
-	_ = trxSeqDBMgr.DBClose()
-	_ = rawTrxDBMgr.DBClose()
+	appDBClose()
 
 	return nil
 }
@@ -185,11 +307,25 @@ func main() {
 		return
 	}
 
+	// verify modifies nothing, the dbs are opened read only
+	if len(os.Args) > 1 && os.Args[1] == "verify" {
+		isDBReadOnly = true
+	}
 	err = appInit()
 	if err != nil {
 		fmt.Println("appInit", err)
 		return
 	}
+	if len(os.Args) > 1 {
+		isContinue, err := appCli(os.Args[1:])
+		if err != nil {
+			fmt.Println("appCli", err)
+		}
+		if err != nil || !isContinue {
+			appDBClose()
+			return
+		}
+	}
 	err = appRun()
 	if err != nil {
 		fmt.Println("appRun", err)
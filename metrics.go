@@ -0,0 +1,348 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"github.com/gorilla/rpc"
+	"net/http"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	MetricsNamespace = "spv"
+	// blocks/sec and trxs/sec are averaged over the last seconds
+	MetricsRateWindowSeconds = 60
+)
+
+// upper bounds of the latency buckets in seconds
+var metricsLatencyBuckets = []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30}
+
+// latencyHistogram has its own lock, so that the observations of different keys do not contend
+type latencyHistogram struct {
+	mutex        *sync.Mutex
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+}
+
+func newLatencyHistogram() *latencyHistogram {
+	return &latencyHistogram{mutex: new(sync.Mutex), bucketCounts: make([]uint64, len(metricsLatencyBuckets))}
+}
+
+func (h *latencyHistogram) observe(seconds float64) {
+	h.mutex.Lock()
+	for i, upperBound := range metricsLatencyBuckets {
+		if seconds <= upperBound {
+			h.bucketCounts[i] += 1
+		}
+	}
+	h.count += 1
+	h.sum += seconds
+	h.mutex.Unlock()
+}
+
+// snapshot copies the histogram to be written without its lock
+func (h *latencyHistogram) snapshot() latencyHistogram {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return latencyHistogram{bucketCounts: append([]uint64{}, h.bucketCounts...), count: h.count, sum: h.sum}
+}
+
+// rateWindow counts the events of each second in a ring of the window
+type rateWindow struct {
+	seconds [MetricsRateWindowSeconds]int64
+	counts  [MetricsRateWindowSeconds]uint64
+}
+
+func (w *rateWindow) add(now time.Time, count uint64) {
+	second := now.Unix()
+	i := second % MetricsRateWindowSeconds
+	if w.seconds[i] != second {
+		w.seconds[i] = second
+		w.counts[i] = 0
+	}
+	w.counts[i] += count
+}
+
+func (w *rateWindow) rate(now time.Time) float64 {
+	second := now.Unix()
+	var total uint64 = 0
+	for i := 0; i < MetricsRateWindowSeconds; i++ {
+		if w.seconds[i] <= second && second-w.seconds[i] < MetricsRateWindowSeconds {
+			total += w.counts[i]
+		}
+	}
+	return float64(total) / MetricsRateWindowSeconds
+}
+
+type rpcMetricsKey struct {
+	protocol string
+	method   string
+}
+
+// the requests and errors are counted atomically
+type rpcMetrics struct {
+	requests uint64
+	errors   uint64
+	latency  *latencyHistogram
+}
+
+type dbMetricsKey struct {
+	db string
+	op string
+}
+
+// Metrics guards the indexer counters by Mutex, the maps by their read write locks,
+// the db and rpc observations take the read lock only once their keys exist
+type Metrics struct {
+	Mutex           *sync.Mutex
+	NodeBlockHeight uint32
+	ProcessedBlocks uint64
+	ProcessedTrxs   uint64
+	BlockRate       rateWindow
+	TrxRate         rateWindow
+	FlushLatency    *latencyHistogram
+	DBMutex         *sync.RWMutex
+	DBLatencies     map[dbMetricsKey]*latencyHistogram
+	RpcMutex        *sync.RWMutex
+	RpcRequests     map[rpcMetricsKey]*rpcMetrics
+}
+
+var metrics = Metrics{Mutex: new(sync.Mutex), FlushLatency: newLatencyHistogram(),
+	DBMutex: new(sync.RWMutex), DBLatencies: make(map[dbMetricsKey]*latencyHistogram),
+	RpcMutex: new(sync.RWMutex), RpcRequests: make(map[rpcMetricsKey]*rpcMetrics)}
+
+func (m *Metrics) SetNodeBlockHeight(blockHeight uint32) {
+	m.Mutex.Lock()
+	m.NodeBlockHeight = blockHeight
+	m.Mutex.Unlock()
+}
+
+func (m *Metrics) AddProcessedBlock(trxCount uint32) {
+	now := time.Now()
+	m.Mutex.Lock()
+	m.ProcessedBlocks += 1
+	m.ProcessedTrxs += uint64(trxCount)
+	m.BlockRate.add(now, 1)
+	m.TrxRate.add(now, uint64(trxCount))
+	m.Mutex.Unlock()
+}
+
+func (m *Metrics) ObserveFlush(startTime time.Time) {
+	m.FlushLatency.observe(time.Since(startTime).Seconds())
+}
+
+func (m *Metrics) getDBLatency(key dbMetricsKey) *latencyHistogram {
+	m.DBMutex.RLock()
+	histogram, ok := m.DBLatencies[key]
+	m.DBMutex.RUnlock()
+	if ok {
+		return histogram
+	}
+	m.DBMutex.Lock()
+	defer m.DBMutex.Unlock()
+	histogram, ok = m.DBLatencies[key]
+	if !ok {
+		histogram = newLatencyHistogram()
+		m.DBLatencies[key] = histogram
+	}
+	return histogram
+}
+
+// ObserveDB records the latency of a read or write of the db
+func (m *Metrics) ObserveDB(dbName string, op string, startTime time.Time) {
+	seconds := time.Since(startTime).Seconds()
+	m.getDBLatency(dbMetricsKey{dbName, op}).observe(seconds)
+}
+
+func (m *Metrics) getRpcMetrics(key rpcMetricsKey) *rpcMetrics {
+	m.RpcMutex.RLock()
+	requestMetrics, ok := m.RpcRequests[key]
+	m.RpcMutex.RUnlock()
+	if ok {
+		return requestMetrics
+	}
+	m.RpcMutex.Lock()
+	defer m.RpcMutex.Unlock()
+	requestMetrics, ok = m.RpcRequests[key]
+	if !ok {
+		requestMetrics = &rpcMetrics{latency: newLatencyHistogram()}
+		m.RpcRequests[key] = requestMetrics
+	}
+	return requestMetrics
+}
+
+// ObserveRpc records a call of the Service method, by "jsonrpc" or "grpc"
+func (m *Metrics) ObserveRpc(protocol string, methodName string, startTime time.Time, err error) {
+	seconds := time.Since(startTime).Seconds()
+	requestMetrics := m.getRpcMetrics(rpcMetricsKey{protocol, methodName})
+	atomic.AddUint64(&requestMetrics.requests, 1)
+	if err != nil {
+		atomic.AddUint64(&requestMetrics.errors, 1)
+	}
+	requestMetrics.latency.observe(seconds)
+}
+
+var metricsLabelReplacer = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n")
+
+func formatMetricsLabels(labels ...string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, labels[i]+"=\""+metricsLabelReplacer.Replace(labels[i+1])+"\"")
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatMetricsValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+type metricsWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *metricsWriter) header(name string, metricType string, help string) {
+	w.buf.WriteString("# HELP " + MetricsNamespace + "_" + name + " " + help + "\n")
+	w.buf.WriteString("# TYPE " + MetricsNamespace + "_" + name + " " + metricType + "\n")
+}
+
+func (w *metricsWriter) sample(name string, value float64, labels ...string) {
+	w.buf.WriteString(MetricsNamespace + "_" + name + formatMetricsLabels(labels...) + " " + formatMetricsValue(value) + "\n")
+}
+
+func (w *metricsWriter) histogram(name string, histogram *latencyHistogram, labels ...string) {
+	h := histogram.snapshot()
+	for i, upperBound := range metricsLatencyBuckets {
+		w.sample(name+"_bucket", float64(h.bucketCounts[i]), append(labels, "le", formatMetricsValue(upperBound))...)
+	}
+	w.sample(name+"_bucket", float64(h.count), append(labels, "le", "+Inf")...)
+	w.sample(name+"_sum", h.sum, labels...)
+	w.sample(name+"_count", float64(h.count), labels...)
+}
+
+// writeMetrics writes all the metrics in the prometheus text format,
+// the locks are held only to copy the values, not while writing them
+func writeMetrics(w *metricsWriter) {
+	// the height the queries see, the blocks above it are in the slot cache only
+	indexedBlockHeight := getFlushedBlockHeight()
+	slotCacheWeight := slotCache.CalcObjectCacheWeight()
+
+	now := time.Now()
+	metrics.Mutex.Lock()
+	nodeBlockHeight := metrics.NodeBlockHeight
+	processedBlocks := metrics.ProcessedBlocks
+	processedTrxs := metrics.ProcessedTrxs
+	blockRate := metrics.BlockRate.rate(now)
+	trxRate := metrics.TrxRate.rate(now)
+	metrics.Mutex.Unlock()
+
+	w.header("indexed_block_height", "gauge", "Height of the last block flushed to the db.")
+	w.sample("indexed_block_height", float64(indexedBlockHeight))
+	w.header("node_block_height", "gauge", "Block count reported by the node.")
+	w.sample("node_block_height", float64(nodeBlockHeight))
+	var lag float64 = 0
+	if nodeBlockHeight > indexedBlockHeight {
+		lag = float64(nodeBlockHeight - indexedBlockHeight)
+	}
+	w.header("index_lag_blocks", "gauge", "Blocks the index is behind the node.")
+	w.sample("index_lag_blocks", lag)
+
+	w.header("processed_blocks_total", "counter", "Blocks processed by the indexer.")
+	w.sample("processed_blocks_total", float64(processedBlocks))
+	w.header("processed_trxs_total", "counter", "Transactions processed by the indexer.")
+	w.sample("processed_trxs_total", float64(processedTrxs))
+	w.header("processed_blocks_per_second", "gauge", "Blocks processed per second over the last minute.")
+	w.sample("processed_blocks_per_second", blockRate)
+	w.header("processed_trxs_per_second", "gauge", "Transactions processed per second over the last minute.")
+	w.sample("processed_trxs_per_second", trxRate)
+
+	w.header("slot_cache_weight", "gauge", "Object weight of the slot cache.")
+	w.sample("slot_cache_weight", float64(slotCacheWeight))
+	w.header("slot_cache_flush_duration_seconds", "histogram", "Duration of flushing the slot cache to the db.")
+	w.histogram("slot_cache_flush_duration_seconds", metrics.FlushLatency)
+
+	metrics.DBMutex.RLock()
+	dbLatencies := make(map[dbMetricsKey]*latencyHistogram, len(metrics.DBLatencies))
+	dbKeys := make([]dbMetricsKey, 0, len(metrics.DBLatencies))
+	for key, histogram := range metrics.DBLatencies {
+		dbLatencies[key] = histogram
+		dbKeys = append(dbKeys, key)
+	}
+	metrics.DBMutex.RUnlock()
+	sort.Slice(dbKeys, func(i, j int) bool {
+		if dbKeys[i].db != dbKeys[j].db {
+			return dbKeys[i].db < dbKeys[j].db
+		}
+		return dbKeys[i].op < dbKeys[j].op
+	})
+	w.header("db_operation_duration_seconds", "histogram", "Latency of the db reads and writes.")
+	for _, key := range dbKeys {
+		w.histogram("db_operation_duration_seconds", dbLatencies[key], "db", key.db, "op", key.op)
+	}
+
+	metrics.RpcMutex.RLock()
+	rpcRequests := make(map[rpcMetricsKey]*rpcMetrics, len(metrics.RpcRequests))
+	rpcKeys := make([]rpcMetricsKey, 0, len(metrics.RpcRequests))
+	for key, requestMetrics := range metrics.RpcRequests {
+		rpcRequests[key] = requestMetrics
+		rpcKeys = append(rpcKeys, key)
+	}
+	metrics.RpcMutex.RUnlock()
+	sort.Slice(rpcKeys, func(i, j int) bool {
+		if rpcKeys[i].protocol != rpcKeys[j].protocol {
+			return rpcKeys[i].protocol < rpcKeys[j].protocol
+		}
+		return rpcKeys[i].method < rpcKeys[j].method
+	})
+	w.header("rpc_requests_total", "counter", "Calls of the rpc methods.")
+	for _, key := range rpcKeys {
+		w.sample("rpc_requests_total", float64(atomic.LoadUint64(&rpcRequests[key].requests)), "protocol", key.protocol, "method", key.method)
+	}
+	w.header("rpc_errors_total", "counter", "Calls of the rpc methods which returned an error.")
+	for _, key := range rpcKeys {
+		w.sample("rpc_errors_total", float64(atomic.LoadUint64(&rpcRequests[key].errors)), "protocol", key.protocol, "method", key.method)
+	}
+	w.header("rpc_request_duration_seconds", "histogram", "Latency of the rpc methods.")
+	for _, key := range rpcKeys {
+		w.histogram("rpc_request_duration_seconds", rpcRequests[key].latency, "protocol", key.protocol, "method", key.method)
+	}
+
+	w.header("managed_goroutines", "gauge", "Goroutines running in the goroutine manager.")
+	w.sample("managed_goroutines", float64(goroutineMgr.GoroutineCount()))
+	w.header("goroutines", "gauge", "Goroutines of the process.")
+	w.sample("goroutines", float64(runtime.NumGoroutine()))
+}
+
+func handleMetrics(w http.ResponseWriter, r *http.Request) {
+	var writer metricsWriter
+	writeMetrics(&writer)
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(writer.buf.Bytes())
+}
+
+type rpcStartTimeKey struct{}
+
+// the json rpc 1.0 calls of the gorilla rpc server are timed by its intercept and after functions
+func registerRpcMetricsFuncs(rpcServer *rpc.Server) {
+	rpcServer.RegisterInterceptFunc(func(i *rpc.RequestInfo) *http.Request {
+		return i.Request.WithContext(context.WithValue(i.Request.Context(), rpcStartTimeKey{}, time.Now()))
+	})
+	rpcServer.RegisterAfterFunc(func(i *rpc.RequestInfo) {
+		startTime, ok := i.Request.Context().Value(rpcStartTimeKey{}).(time.Time)
+		if !ok {
+			return
+		}
+		methodName := i.Method[strings.LastIndex(i.Method, ".")+1:]
+		metrics.ObserveRpc("jsonrpc", methodName, startTime, i.Error)
+	})
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"crypto/sha256"
+	"errors"
+	"golang.org/x/crypto/chacha20"
+	"io"
+	"math/big"
+)
+
+// MuHash3072 is the rolling set hash of bitcoin core (gettxoutsetinfo hash_type=muhash),
+// the elements are multiplied into the numerator or the denominator mod 2^3072 - 1103717
+const (
+	MuHash3072ByteSize  = 384
+	MuHash3072BitSize   = 3072
+	MuHash3072PrimeDiff = 1103717
+)
+
+var muHash3072PrimeDiff = big.NewInt(MuHash3072PrimeDiff)
+var muHash3072Mask = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), MuHash3072BitSize), big.NewInt(1))
+var muHash3072Prime = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), MuHash3072BitSize), muHash3072PrimeDiff)
+
+type MuHash3072 struct {
+	Numerator   *big.Int
+	Denominator *big.Int
+}
+
+func newMuHash3072() MuHash3072 {
+	return MuHash3072{big.NewInt(1), big.NewInt(1)}
+}
+
+// muHash3072Reduce reduces x mod p by 2^3072 = 1103717 (mod p)
+func muHash3072Reduce(x *big.Int) *big.Int {
+	for x.BitLen() > MuHash3072BitSize {
+		high := new(big.Int).Rsh(x, MuHash3072BitSize)
+		x.And(x, muHash3072Mask)
+		x.Add(x, high.Mul(high, muHash3072PrimeDiff))
+	}
+	if x.Cmp(muHash3072Prime) >= 0 {
+		x.Sub(x, muHash3072Prime)
+	}
+	return x
+}
+
+// the numbers are little endian like Num3072 of bitcoin core
+func muHash3072FromBytes(data []byte) *big.Int {
+	bytesBig := make([]byte, len(data))
+	for i := range data {
+		bytesBig[len(data)-1-i] = data[i]
+	}
+	return new(big.Int).SetBytes(bytesBig)
+}
+
+func muHash3072ToBytes(x *big.Int) []byte {
+	bytesBig := x.Bytes()
+	data := make([]byte, MuHash3072ByteSize)
+	for i := range bytesBig {
+		data[len(bytesBig)-1-i] = bytesBig[i]
+	}
+	return data
+}
+
+// muHash3072ToNum expands sha256(data) to 3072 bits by the chacha20 key stream
+func muHash3072ToNum(data []byte) (*big.Int, error) {
+	key := sha256.Sum256(data)
+	cipher, err := chacha20.NewUnauthenticatedCipher(key[0:], make([]byte, chacha20.NonceSize))
+	if err != nil {
+		return nil, err
+	}
+	keyStream := make([]byte, MuHash3072ByteSize)
+	cipher.XORKeyStream(keyStream, keyStream)
+	return muHash3072FromBytes(keyStream), nil
+}
+
+func (m *MuHash3072) Insert(data []byte) error {
+	x, err := muHash3072ToNum(data)
+	if err != nil {
+		return err
+	}
+	m.Numerator = muHash3072Reduce(new(big.Int).Mul(m.Numerator, x))
+	return nil
+}
+
+func (m *MuHash3072) Remove(data []byte) error {
+	x, err := muHash3072ToNum(data)
+	if err != nil {
+		return err
+	}
+	m.Denominator = muHash3072Reduce(new(big.Int).Mul(m.Denominator, x))
+	return nil
+}
+
+func (m MuHash3072) Clone() MuHash3072 {
+	return MuHash3072{new(big.Int).Set(m.Numerator), new(big.Int).Set(m.Denominator)}
+}
+
+// value returns numerator / denominator
+func (m MuHash3072) value() *big.Int {
+	inverse := new(big.Int).ModInverse(m.Denominator, muHash3072Prime)
+	return muHash3072Reduce(inverse.Mul(inverse, m.Numerator))
+}
+
+// Finalize returns the hash in the byte order of bitcoin core, GetHex of uint256 reverses it
+func (m MuHash3072) Finalize() [32]byte {
+	return sha256.Sum256(muHash3072ToBytes(m.value()))
+}
+
+// Pack stores numerator / denominator only, the denominator is 1 after UnPack
+func (m MuHash3072) Pack(writer io.Writer) error {
+	_, err := writer.Write(muHash3072ToBytes(m.value()))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *MuHash3072) UnPack(reader io.Reader) error {
+	data := make([]byte, MuHash3072ByteSize)
+	_, err := io.ReadFull(reader, data)
+	if err != nil {
+		return err
+	}
+	x := muHash3072FromBytes(data)
+	if x.Sign() == 0 || x.Cmp(muHash3072Prime) >= 0 {
+		return errors.New("invalid muhash")
+	}
+	m.Numerator = x
+	m.Denominator = big.NewInt(1)
+	return nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func muHashTestElement(i byte) []byte {
+	data := make([]byte, 32)
+	data[0] = i
+	return data
+}
+
+// the vector of muhash_tests in crypto_tests.cpp of bitcoin core
+func TestMuHash3072(t *testing.T) {
+	muHash := newMuHash3072()
+	for _, step := range []struct {
+		element  byte
+		isInsert bool
+	}{{0, true}, {1, true}, {2, false}} {
+		var err error
+		if step.isInsert {
+			err = muHash.Insert(muHashTestElement(step.element))
+		} else {
+			err = muHash.Remove(muHashTestElement(step.element))
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	hash := muHash.Finalize()
+	for i := 0; i < len(hash)/2; i++ {
+		hash[i], hash[len(hash)-1-i] = hash[len(hash)-1-i], hash[i]
+	}
+	expected := "10d312b100cbd32ada024a6646e40d3482fcff103668d2625f10002a607d5863"
+	if hex.EncodeToString(hash[0:]) != expected {
+		t.Fatalf("muhash %x, expected %s", hash, expected)
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/base58"
+	"github.com/mutalisk999/bitcoin-lib/src/keyid"
+	"github.com/mutalisk999/bitcoin-lib/src/utility"
+	"strings"
+)
+
+// NetworkParams are the address and extended public key encodings of a network
+type NetworkParams struct {
+	Name              string
+	PubKeyHashVersion byte
+	ScriptHashVersion byte
+	SegWitHrp         string
+	// the extended public key versions of BIP44/49/84, BIP86 shares the BIP44 version
+	XpubVersions map[string]string
+}
+
+var networkParamsList = map[string]NetworkParams{
+	"mainnet": {"mainnet", 0, 5, SegWitHrpMainNet, map[string]string{
+		"0488b21e": XpubScriptTypeP2PKH,
+		"049d7cb2": XpubScriptTypeP2SHWPK,
+		"04b24746": XpubScriptTypeP2WPKH,
+	}},
+	"testnet": {"testnet", 111, 196, SegWitHrpTestNet, map[string]string{
+		"043587cf": XpubScriptTypeP2PKH,
+		"044a5262": XpubScriptTypeP2SHWPK,
+		"045f1cf6": XpubScriptTypeP2WPKH,
+	}},
+	"regtest": {"regtest", 111, 196, SegWitHrpRegTest, map[string]string{
+		"043587cf": XpubScriptTypeP2PKH,
+		"044a5262": XpubScriptTypeP2SHWPK,
+		"045f1cf6": XpubScriptTypeP2WPKH,
+	}},
+}
+
+// the params of the network of the config, loaded by appInit
+var networkParams = networkParamsList["mainnet"]
+
+func getNetworkParams() (NetworkParams, error) {
+	networkName := config.GatherConfig.Network
+	if networkName == "" {
+		networkName = "mainnet"
+	}
+	params, ok := networkParamsList[networkName]
+	if !ok {
+		return NetworkParams{}, errors.New("invalid network: " + networkName)
+	}
+	return params, nil
+}
+
+// getIndexNetwork returns the network the db is indexed for, the dbs indexed before it is recorded are mainnet
+func getIndexNetwork() (string, error) {
+	networkName, err := globalConfigDBMgr.DBGet("network")
+	if err != nil {
+		if err.Error() != NotFoundError {
+			return "", err
+		}
+		blockHeight, err := getStartBlockHeight()
+		if err != nil {
+			return "", err
+		}
+		if blockHeight != 0 {
+			return "mainnet", nil
+		}
+		return "", nil
+	}
+	return networkName, nil
+}
+
+func storeIndexNetwork(networkName string) error {
+	err := globalConfigDBMgr.DBPut("network", networkName)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// loadNetworkParams checks the network of the config is the network of the db
+func loadNetworkParams() error {
+	params, err := getNetworkParams()
+	if err != nil {
+		return err
+	}
+	networkName, err := getIndexNetwork()
+	if err != nil {
+		return err
+	}
+	if networkName != "" && networkName != params.Name {
+		return errors.New("the db is indexed for network " + networkName + ", not " + params.Name)
+	}
+	networkParams = params
+	return nil
+}
+
+// convertAddressNetwork encodes the mainnet address of bitcoin-lib for the network
+func convertAddressNetwork(addrStr string, params NetworkParams) (string, error) {
+	if params.Name == "mainnet" {
+		return addrStr, nil
+	}
+	if strings.HasPrefix(addrStr, SegWitHrpMainNet+"1") {
+		version, program, err := decodeSegWitAddress(SegWitHrpMainNet, addrStr)
+		if err != nil {
+			return "", err
+		}
+		return encodeSegWitAddress(params.SegWitHrp, version, program)
+	}
+	addrBytes, err := base58.Decode(addrStr)
+	if err != nil {
+		return "", err
+	}
+	if len(addrBytes) != 25 {
+		return "", errors.New("invalid address size")
+	}
+	checkSum := utility.Sha256(utility.Sha256(addrBytes[0:21]))[0:4]
+	if !bytes.Equal(checkSum, addrBytes[21:25]) {
+		return "", errors.New("invalid address checksum")
+	}
+	var keyId keyid.KeyID
+	err = keyId.SetKeyIDData(addrBytes[1:21])
+	if err != nil {
+		return "", err
+	}
+	if addrBytes[0] == 0 {
+		return keyId.ToBase58Address(params.PubKeyHashVersion)
+	} else if addrBytes[0] == 5 {
+		return keyId.ToBase58Address(params.ScriptHashVersion)
+	}
+	return "", errors.New("not support address version")
+}
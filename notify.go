@@ -0,0 +1,56 @@
+package main
+
+import (
+	"sync"
+)
+
+const IndexFlushEventQueueSize = 64
+
+// IndexFlushEvent tells the blocks and trxs written to the db by a flush of the slot cache
+type IndexFlushEvent struct {
+	FromBlockHeight uint32
+	ToBlockHeight   uint32
+	FromTrxSeq      uint32
+	ToTrxSeq        uint32
+	// the height suffix of the addr_trx_db keys written by the flush
+	AddrTrxsKeyHeight uint32
+}
+
+type IndexFlushNotifier struct {
+	Mutex       *sync.Mutex
+	Subscribers map[chan IndexFlushEvent]bool
+}
+
+var indexFlushNotifier = IndexFlushNotifier{new(sync.Mutex), make(map[chan IndexFlushEvent]bool)}
+
+func (n *IndexFlushNotifier) Subscribe() chan IndexFlushEvent {
+	ch := make(chan IndexFlushEvent, IndexFlushEventQueueSize)
+	n.Mutex.Lock()
+	n.Subscribers[ch] = true
+	n.Mutex.Unlock()
+	return ch
+}
+
+func (n *IndexFlushNotifier) UnSubscribe(ch chan IndexFlushEvent) {
+	n.Mutex.Lock()
+	_, ok := n.Subscribers[ch]
+	if ok {
+		delete(n.Subscribers, ch)
+		close(ch)
+	}
+	n.Mutex.Unlock()
+}
+
+// Notify never blocks the gather, the channel of a subscriber falling behind is closed
+func (n *IndexFlushNotifier) Notify(event IndexFlushEvent) {
+	n.Mutex.Lock()
+	for ch := range n.Subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(n.Subscribers, ch)
+			close(ch)
+		}
+	}
+	n.Mutex.Unlock()
+}
@@ -0,0 +1,5636 @@
+// the field names follow the json field names of the rpc server,
+// so that a reply of the rpc server and a message here share the same json layout.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        (unknown)
+// source: spv.proto
+
+package protos
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type EmptyArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyArgs) Reset() {
+	*x = EmptyArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyArgs) ProtoMessage() {}
+
+func (x *EmptyArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyArgs.ProtoReflect.Descriptor instead.
+func (*EmptyArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{0}
+}
+
+type CountReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count uint32 `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *CountReply) Reset() {
+	*x = CountReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountReply) ProtoMessage() {}
+
+func (x *CountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountReply.ProtoReflect.Descriptor instead.
+func (*CountReply) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CountReply) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TrxSeqArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxSeq uint32 `protobuf:"varint,1,opt,name=TrxSeq,proto3" json:"TrxSeq,omitempty"`
+}
+
+func (x *TrxSeqArgs) Reset() {
+	*x = TrxSeqArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrxSeqArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrxSeqArgs) ProtoMessage() {}
+
+func (x *TrxSeqArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrxSeqArgs.ProtoReflect.Descriptor instead.
+func (*TrxSeqArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TrxSeqArgs) GetTrxSeq() uint32 {
+	if x != nil {
+		return x.TrxSeq
+	}
+	return 0
+}
+
+type TrxIdArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxId string `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+}
+
+func (x *TrxIdArgs) Reset() {
+	*x = TrxIdArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrxIdArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrxIdArgs) ProtoMessage() {}
+
+func (x *TrxIdArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrxIdArgs.ProtoReflect.Descriptor instead.
+func (*TrxIdArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TrxIdArgs) GetTrxId() string {
+	if x != nil {
+		return x.TrxId
+	}
+	return ""
+}
+
+type TrxIdReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxId string `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+}
+
+func (x *TrxIdReply) Reset() {
+	*x = TrxIdReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrxIdReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrxIdReply) ProtoMessage() {}
+
+func (x *TrxIdReply) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrxIdReply.ProtoReflect.Descriptor instead.
+func (*TrxIdReply) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TrxIdReply) GetTrxId() string {
+	if x != nil {
+		return x.TrxId
+	}
+	return ""
+}
+
+type AddressArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+}
+
+func (x *AddressArgs) Reset() {
+	*x = AddressArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressArgs) ProtoMessage() {}
+
+func (x *AddressArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressArgs.ProtoReflect.Descriptor instead.
+func (*AddressArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddressArgs) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AddressesArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (x *AddressesArgs) Reset() {
+	*x = AddressesArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressesArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressesArgs) ProtoMessage() {}
+
+func (x *AddressesArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressesArgs.ProtoReflect.Descriptor instead.
+func (*AddressesArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddressesArgs) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type BlockHeightArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint32 `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+}
+
+func (x *BlockHeightArgs) Reset() {
+	*x = BlockHeightArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockHeightArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeightArgs) ProtoMessage() {}
+
+func (x *BlockHeightArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeightArgs.ProtoReflect.Descriptor instead.
+func (*BlockHeightArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BlockHeightArgs) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+type TrxStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxId         string `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	TrxSeq        uint32 `protobuf:"varint,2,opt,name=TrxSeq,proto3" json:"TrxSeq,omitempty"`
+	BlockHeight   uint32 `protobuf:"varint,3,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	BlockIndex    uint32 `protobuf:"varint,4,opt,name=BlockIndex,proto3" json:"BlockIndex,omitempty"`
+	BlockHash     string `protobuf:"bytes,5,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Confirmations uint32 `protobuf:"varint,6,opt,name=Confirmations,proto3" json:"Confirmations,omitempty"`
+}
+
+func (x *TrxStatus) Reset() {
+	*x = TrxStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrxStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrxStatus) ProtoMessage() {}
+
+func (x *TrxStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrxStatus.ProtoReflect.Descriptor instead.
+func (*TrxStatus) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TrxStatus) GetTrxId() string {
+	if x != nil {
+		return x.TrxId
+	}
+	return ""
+}
+
+func (x *TrxStatus) GetTrxSeq() uint32 {
+	if x != nil {
+		return x.TrxSeq
+	}
+	return 0
+}
+
+func (x *TrxStatus) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *TrxStatus) GetBlockIndex() uint32 {
+	if x != nil {
+		return x.BlockIndex
+	}
+	return 0
+}
+
+func (x *TrxStatus) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *TrxStatus) GetConfirmations() uint32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+type TrxStatusList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trxs []*TrxStatus `protobuf:"bytes,1,rep,name=Trxs,proto3" json:"Trxs,omitempty"`
+}
+
+func (x *TrxStatusList) Reset() {
+	*x = TrxStatusList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrxStatusList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrxStatusList) ProtoMessage() {}
+
+func (x *TrxStatusList) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrxStatusList.ProtoReflect.Descriptor instead.
+func (*TrxStatusList) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TrxStatusList) GetTrxs() []*TrxStatus {
+	if x != nil {
+		return x.Trxs
+	}
+	return nil
+}
+
+type BlockQueryArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeightOrHash string `protobuf:"bytes,1,opt,name=HeightOrHash,proto3" json:"HeightOrHash,omitempty"`
+	Start        uint32 `protobuf:"varint,2,opt,name=Start,proto3" json:"Start,omitempty"`
+	Count        uint32 `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *BlockQueryArgs) Reset() {
+	*x = BlockQueryArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockQueryArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockQueryArgs) ProtoMessage() {}
+
+func (x *BlockQueryArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockQueryArgs.ProtoReflect.Descriptor instead.
+func (*BlockQueryArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BlockQueryArgs) GetHeightOrHash() string {
+	if x != nil {
+		return x.HeightOrHash
+	}
+	return ""
+}
+
+func (x *BlockQueryArgs) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *BlockQueryArgs) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Block struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight   uint32   `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	BlockHash     string   `protobuf:"bytes,2,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Version       int32    `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	PrevBlockHash string   `protobuf:"bytes,4,opt,name=PrevBlockHash,proto3" json:"PrevBlockHash,omitempty"`
+	MerkleRoot    string   `protobuf:"bytes,5,opt,name=MerkleRoot,proto3" json:"MerkleRoot,omitempty"`
+	Time          uint32   `protobuf:"varint,6,opt,name=Time,proto3" json:"Time,omitempty"`
+	Bits          uint32   `protobuf:"varint,7,opt,name=Bits,proto3" json:"Bits,omitempty"`
+	Nonce         uint32   `protobuf:"varint,8,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Size          uint32   `protobuf:"varint,9,opt,name=Size,proto3" json:"Size,omitempty"`
+	Confirmations uint32   `protobuf:"varint,10,opt,name=Confirmations,proto3" json:"Confirmations,omitempty"`
+	TrxCount      uint32   `protobuf:"varint,11,opt,name=TrxCount,proto3" json:"TrxCount,omitempty"`
+	TotalOut      int64    `protobuf:"varint,12,opt,name=TotalOut,proto3" json:"TotalOut,omitempty"`
+	TotalFee      int64    `protobuf:"varint,13,opt,name=TotalFee,proto3" json:"TotalFee,omitempty"`
+	Start         uint32   `protobuf:"varint,14,opt,name=Start,proto3" json:"Start,omitempty"`
+	TrxIds        []string `protobuf:"bytes,15,rep,name=TrxIds,proto3" json:"TrxIds,omitempty"`
+}
+
+func (x *Block) Reset() {
+	*x = Block{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block) ProtoMessage() {}
+
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Block) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *Block) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *Block) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Block) GetPrevBlockHash() string {
+	if x != nil {
+		return x.PrevBlockHash
+	}
+	return ""
+}
+
+func (x *Block) GetMerkleRoot() string {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return ""
+}
+
+func (x *Block) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Block) GetBits() uint32 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+func (x *Block) GetNonce() uint32 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *Block) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Block) GetConfirmations() uint32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *Block) GetTrxCount() uint32 {
+	if x != nil {
+		return x.TrxCount
+	}
+	return 0
+}
+
+func (x *Block) GetTotalOut() int64 {
+	if x != nil {
+		return x.TotalOut
+	}
+	return 0
+}
+
+func (x *Block) GetTotalFee() int64 {
+	if x != nil {
+		return x.TotalFee
+	}
+	return 0
+}
+
+func (x *Block) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Block) GetTrxIds() []string {
+	if x != nil {
+		return x.TrxIds
+	}
+	return nil
+}
+
+type RawTrxReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RawTrx string `protobuf:"bytes,1,opt,name=RawTrx,proto3" json:"RawTrx,omitempty"`
+}
+
+func (x *RawTrxReply) Reset() {
+	*x = RawTrxReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawTrxReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawTrxReply) ProtoMessage() {}
+
+func (x *RawTrxReply) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawTrxReply.ProtoReflect.Descriptor instead.
+func (*RawTrxReply) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RawTrxReply) GetRawTrx() string {
+	if x != nil {
+		return x.RawTrx
+	}
+	return ""
+}
+
+type OutPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	N    uint32 `protobuf:"varint,2,opt,name=N,proto3" json:"N,omitempty"`
+}
+
+func (x *OutPoint) Reset() {
+	*x = OutPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutPoint) ProtoMessage() {}
+
+func (x *OutPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutPoint.ProtoReflect.Descriptor instead.
+func (*OutPoint) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OutPoint) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *OutPoint) GetN() uint32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type TxIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrevOut       *OutPoint `protobuf:"bytes,1,opt,name=PrevOut,proto3" json:"PrevOut,omitempty"`
+	ScriptSig     string    `protobuf:"bytes,2,opt,name=ScriptSig,proto3" json:"ScriptSig,omitempty"`
+	Sequence      uint32    `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	ScriptWitness []string  `protobuf:"bytes,4,rep,name=ScriptWitness,proto3" json:"ScriptWitness,omitempty"`
+}
+
+func (x *TxIn) Reset() {
+	*x = TxIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxIn) ProtoMessage() {}
+
+func (x *TxIn) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxIn.ProtoReflect.Descriptor instead.
+func (*TxIn) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TxIn) GetPrevOut() *OutPoint {
+	if x != nil {
+		return x.PrevOut
+	}
+	return nil
+}
+
+func (x *TxIn) GetScriptSig() string {
+	if x != nil {
+		return x.ScriptSig
+	}
+	return ""
+}
+
+func (x *TxIn) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TxIn) GetScriptWitness() []string {
+	if x != nil {
+		return x.ScriptWitness
+	}
+	return nil
+}
+
+type TxOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value        int64  `protobuf:"varint,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	ScriptPubKey string `protobuf:"bytes,2,opt,name=ScriptPubKey,proto3" json:"ScriptPubKey,omitempty"`
+	Address      string `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	ScriptType   string `protobuf:"bytes,4,opt,name=ScriptType,proto3" json:"ScriptType,omitempty"`
+}
+
+func (x *TxOut) Reset() {
+	*x = TxOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxOut) ProtoMessage() {}
+
+func (x *TxOut) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxOut.ProtoReflect.Descriptor instead.
+func (*TxOut) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TxOut) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *TxOut) GetScriptPubKey() string {
+	if x != nil {
+		return x.ScriptPubKey
+	}
+	return ""
+}
+
+func (x *TxOut) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TxOut) GetScriptType() string {
+	if x != nil {
+		return x.ScriptType
+	}
+	return ""
+}
+
+type Trx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxId    string     `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	Status   *TrxStatus `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	Vin      []*TxIn    `protobuf:"bytes,3,rep,name=Vin,proto3" json:"Vin,omitempty"`
+	Vout     []*TxOut   `protobuf:"bytes,4,rep,name=Vout,proto3" json:"Vout,omitempty"`
+	Version  int32      `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+	LockTime uint32     `protobuf:"varint,6,opt,name=LockTime,proto3" json:"LockTime,omitempty"`
+}
+
+func (x *Trx) Reset() {
+	*x = Trx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trx) ProtoMessage() {}
+
+func (x *Trx) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trx.ProtoReflect.Descriptor instead.
+func (*Trx) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Trx) GetTrxId() string {
+	if x != nil {
+		return x.TrxId
+	}
+	return ""
+}
+
+func (x *Trx) GetStatus() *TrxStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Trx) GetVin() []*TxIn {
+	if x != nil {
+		return x.Vin
+	}
+	return nil
+}
+
+func (x *Trx) GetVout() []*TxOut {
+	if x != nil {
+		return x.Vout
+	}
+	return nil
+}
+
+func (x *Trx) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Trx) GetLockTime() uint32 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
+type TxInVerbose struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrevOut       *OutPoint `protobuf:"bytes,1,opt,name=PrevOut,proto3" json:"PrevOut,omitempty"`
+	ScriptSig     string    `protobuf:"bytes,2,opt,name=ScriptSig,proto3" json:"ScriptSig,omitempty"`
+	Sequence      uint32    `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	ScriptWitness []string  `protobuf:"bytes,4,rep,name=ScriptWitness,proto3" json:"ScriptWitness,omitempty"`
+	Value         int64     `protobuf:"varint,5,opt,name=Value,proto3" json:"Value,omitempty"`
+	Address       string    `protobuf:"bytes,6,opt,name=Address,proto3" json:"Address,omitempty"`
+	ScriptPubKey  string    `protobuf:"bytes,7,opt,name=ScriptPubKey,proto3" json:"ScriptPubKey,omitempty"`
+	ScriptType    string    `protobuf:"bytes,8,opt,name=ScriptType,proto3" json:"ScriptType,omitempty"`
+}
+
+func (x *TxInVerbose) Reset() {
+	*x = TxInVerbose{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxInVerbose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxInVerbose) ProtoMessage() {}
+
+func (x *TxInVerbose) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxInVerbose.ProtoReflect.Descriptor instead.
+func (*TxInVerbose) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TxInVerbose) GetPrevOut() *OutPoint {
+	if x != nil {
+		return x.PrevOut
+	}
+	return nil
+}
+
+func (x *TxInVerbose) GetScriptSig() string {
+	if x != nil {
+		return x.ScriptSig
+	}
+	return ""
+}
+
+func (x *TxInVerbose) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TxInVerbose) GetScriptWitness() []string {
+	if x != nil {
+		return x.ScriptWitness
+	}
+	return nil
+}
+
+func (x *TxInVerbose) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *TxInVerbose) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TxInVerbose) GetScriptPubKey() string {
+	if x != nil {
+		return x.ScriptPubKey
+	}
+	return ""
+}
+
+func (x *TxInVerbose) GetScriptType() string {
+	if x != nil {
+		return x.ScriptType
+	}
+	return ""
+}
+
+type TrxVerbose struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxId    string         `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	Status   *TrxStatus     `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	Vin      []*TxInVerbose `protobuf:"bytes,3,rep,name=Vin,proto3" json:"Vin,omitempty"`
+	Vout     []*TxOut       `protobuf:"bytes,4,rep,name=Vout,proto3" json:"Vout,omitempty"`
+	Version  int32          `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+	LockTime uint32         `protobuf:"varint,6,opt,name=LockTime,proto3" json:"LockTime,omitempty"`
+	Size     int64          `protobuf:"varint,7,opt,name=Size,proto3" json:"Size,omitempty"`
+	VSize    int64          `protobuf:"varint,8,opt,name=VSize,proto3" json:"VSize,omitempty"`
+	Weight   int64          `protobuf:"varint,9,opt,name=Weight,proto3" json:"Weight,omitempty"`
+	Fee      int64          `protobuf:"varint,10,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	FeeRate  float64        `protobuf:"fixed64,11,opt,name=FeeRate,proto3" json:"FeeRate,omitempty"`
+}
+
+func (x *TrxVerbose) Reset() {
+	*x = TrxVerbose{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrxVerbose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrxVerbose) ProtoMessage() {}
+
+func (x *TrxVerbose) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrxVerbose.ProtoReflect.Descriptor instead.
+func (*TrxVerbose) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TrxVerbose) GetTrxId() string {
+	if x != nil {
+		return x.TrxId
+	}
+	return ""
+}
+
+func (x *TrxVerbose) GetStatus() *TrxStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *TrxVerbose) GetVin() []*TxInVerbose {
+	if x != nil {
+		return x.Vin
+	}
+	return nil
+}
+
+func (x *TrxVerbose) GetVout() []*TxOut {
+	if x != nil {
+		return x.Vout
+	}
+	return nil
+}
+
+func (x *TrxVerbose) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TrxVerbose) GetLockTime() uint32 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
+func (x *TrxVerbose) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TrxVerbose) GetVSize() int64 {
+	if x != nil {
+		return x.VSize
+	}
+	return 0
+}
+
+func (x *TrxVerbose) GetWeight() int64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TrxVerbose) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TrxVerbose) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+type UtxoSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxId string `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	Vout  uint32 `protobuf:"varint,2,opt,name=Vout,proto3" json:"Vout,omitempty"`
+}
+
+func (x *UtxoSource) Reset() {
+	*x = UtxoSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoSource) ProtoMessage() {}
+
+func (x *UtxoSource) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoSource.ProtoReflect.Descriptor instead.
+func (*UtxoSource) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UtxoSource) GetTrxId() string {
+	if x != nil {
+		return x.TrxId
+	}
+	return ""
+}
+
+func (x *UtxoSource) GetVout() uint32 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+type UtxoSourceList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Utxos []*UtxoSource `protobuf:"bytes,1,rep,name=Utxos,proto3" json:"Utxos,omitempty"`
+}
+
+func (x *UtxoSourceList) Reset() {
+	*x = UtxoSourceList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoSourceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoSourceList) ProtoMessage() {}
+
+func (x *UtxoSourceList) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoSourceList.ProtoReflect.Descriptor instead.
+func (*UtxoSourceList) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UtxoSourceList) GetUtxos() []*UtxoSource {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+type UtxoDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount       int64  `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	BlockHeight  uint32 `protobuf:"varint,2,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	Address      string `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	ScriptPubKey string `protobuf:"bytes,4,opt,name=ScriptPubKey,proto3" json:"ScriptPubKey,omitempty"`
+}
+
+func (x *UtxoDetail) Reset() {
+	*x = UtxoDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoDetail) ProtoMessage() {}
+
+func (x *UtxoDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoDetail.ProtoReflect.Descriptor instead.
+func (*UtxoDetail) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UtxoDetail) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UtxoDetail) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *UtxoDetail) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UtxoDetail) GetScriptPubKey() string {
+	if x != nil {
+		return x.ScriptPubKey
+	}
+	return ""
+}
+
+type UtxoDetailList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Utxos []*UtxoDetail `protobuf:"bytes,1,rep,name=Utxos,proto3" json:"Utxos,omitempty"`
+}
+
+func (x *UtxoDetailList) Reset() {
+	*x = UtxoDetailList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoDetailList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoDetailList) ProtoMessage() {}
+
+func (x *UtxoDetailList) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoDetailList.ProtoReflect.Descriptor instead.
+func (*UtxoDetailList) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UtxoDetailList) GetUtxos() []*UtxoDetail {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+type Utxo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxId        string `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	Vout         uint32 `protobuf:"varint,2,opt,name=Vout,proto3" json:"Vout,omitempty"`
+	Amount       int64  `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	BlockHeight  uint32 `protobuf:"varint,4,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	Address      string `protobuf:"bytes,5,opt,name=Address,proto3" json:"Address,omitempty"`
+	ScriptPubKey string `protobuf:"bytes,6,opt,name=ScriptPubKey,proto3" json:"ScriptPubKey,omitempty"`
+}
+
+func (x *Utxo) Reset() {
+	*x = Utxo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Utxo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Utxo) ProtoMessage() {}
+
+func (x *Utxo) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Utxo.ProtoReflect.Descriptor instead.
+func (*Utxo) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Utxo) GetTrxId() string {
+	if x != nil {
+		return x.TrxId
+	}
+	return ""
+}
+
+func (x *Utxo) GetVout() uint32 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+func (x *Utxo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Utxo) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *Utxo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Utxo) GetScriptPubKey() string {
+	if x != nil {
+		return x.ScriptPubKey
+	}
+	return ""
+}
+
+type AddressTrxIds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	TrxIds  []string `protobuf:"bytes,2,rep,name=TrxIds,proto3" json:"TrxIds,omitempty"`
+}
+
+func (x *AddressTrxIds) Reset() {
+	*x = AddressTrxIds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressTrxIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressTrxIds) ProtoMessage() {}
+
+func (x *AddressTrxIds) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressTrxIds.ProtoReflect.Descriptor instead.
+func (*AddressTrxIds) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddressTrxIds) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressTrxIds) GetTrxIds() []string {
+	if x != nil {
+		return x.TrxIds
+	}
+	return nil
+}
+
+type AddressesTrxs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []*AddressTrxIds `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+	Trxs      []*TrxStatus     `protobuf:"bytes,2,rep,name=Trxs,proto3" json:"Trxs,omitempty"`
+}
+
+func (x *AddressesTrxs) Reset() {
+	*x = AddressesTrxs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressesTrxs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressesTrxs) ProtoMessage() {}
+
+func (x *AddressesTrxs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressesTrxs.ProtoReflect.Descriptor instead.
+func (*AddressesTrxs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddressesTrxs) GetAddresses() []*AddressTrxIds {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *AddressesTrxs) GetTrxs() []*TrxStatus {
+	if x != nil {
+		return x.Trxs
+	}
+	return nil
+}
+
+type AddressUtxos struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string  `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Utxos   []*Utxo `protobuf:"bytes,2,rep,name=Utxos,proto3" json:"Utxos,omitempty"`
+}
+
+func (x *AddressUtxos) Reset() {
+	*x = AddressUtxos{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressUtxos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressUtxos) ProtoMessage() {}
+
+func (x *AddressUtxos) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressUtxos.ProtoReflect.Descriptor instead.
+func (*AddressUtxos) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddressUtxos) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressUtxos) GetUtxos() []*Utxo {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+type AddressUtxosList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []*AddressUtxos `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (x *AddressUtxosList) Reset() {
+	*x = AddressUtxosList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressUtxosList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressUtxosList) ProtoMessage() {}
+
+func (x *AddressUtxosList) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressUtxosList.ProtoReflect.Descriptor instead.
+func (*AddressUtxosList) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddressUtxosList) GetAddresses() []*AddressUtxos {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type AddressBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Balance   int64  `protobuf:"varint,2,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	UtxoCount uint32 `protobuf:"varint,3,opt,name=UtxoCount,proto3" json:"UtxoCount,omitempty"`
+	TrxCount  uint32 `protobuf:"varint,4,opt,name=TrxCount,proto3" json:"TrxCount,omitempty"`
+}
+
+func (x *AddressBalance) Reset() {
+	*x = AddressBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressBalance) ProtoMessage() {}
+
+func (x *AddressBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressBalance.ProtoReflect.Descriptor instead.
+func (*AddressBalance) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddressBalance) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressBalance) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *AddressBalance) GetUtxoCount() uint32 {
+	if x != nil {
+		return x.UtxoCount
+	}
+	return 0
+}
+
+func (x *AddressBalance) GetTrxCount() uint32 {
+	if x != nil {
+		return x.TrxCount
+	}
+	return 0
+}
+
+type AddressesBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance   int64             `protobuf:"varint,1,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	UtxoCount uint32            `protobuf:"varint,2,opt,name=UtxoCount,proto3" json:"UtxoCount,omitempty"`
+	Addresses []*AddressBalance `protobuf:"bytes,3,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (x *AddressesBalance) Reset() {
+	*x = AddressesBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressesBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressesBalance) ProtoMessage() {}
+
+func (x *AddressesBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressesBalance.ProtoReflect.Descriptor instead.
+func (*AddressesBalance) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddressesBalance) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *AddressesBalance) GetUtxoCount() uint32 {
+	if x != nil {
+		return x.UtxoCount
+	}
+	return 0
+}
+
+func (x *AddressesBalance) GetAddresses() []*AddressBalance {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type XpubScanArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Xpub       string `protobuf:"bytes,1,opt,name=Xpub,proto3" json:"Xpub,omitempty"`
+	ScriptType string `protobuf:"bytes,2,opt,name=ScriptType,proto3" json:"ScriptType,omitempty"`
+	GapLimit   uint32 `protobuf:"varint,3,opt,name=GapLimit,proto3" json:"GapLimit,omitempty"`
+}
+
+func (x *XpubScanArgs) Reset() {
+	*x = XpubScanArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XpubScanArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XpubScanArgs) ProtoMessage() {}
+
+func (x *XpubScanArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XpubScanArgs.ProtoReflect.Descriptor instead.
+func (*XpubScanArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *XpubScanArgs) GetXpub() string {
+	if x != nil {
+		return x.Xpub
+	}
+	return ""
+}
+
+func (x *XpubScanArgs) GetScriptType() string {
+	if x != nil {
+		return x.ScriptType
+	}
+	return ""
+}
+
+func (x *XpubScanArgs) GetGapLimit() uint32 {
+	if x != nil {
+		return x.GapLimit
+	}
+	return 0
+}
+
+type XpubAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Chain     uint32 `protobuf:"varint,2,opt,name=Chain,proto3" json:"Chain,omitempty"`
+	Index     uint32 `protobuf:"varint,3,opt,name=Index,proto3" json:"Index,omitempty"`
+	Address   string `protobuf:"bytes,4,opt,name=Address,proto3" json:"Address,omitempty"`
+	TrxCount  uint32 `protobuf:"varint,5,opt,name=TrxCount,proto3" json:"TrxCount,omitempty"`
+	Balance   int64  `protobuf:"varint,6,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	UtxoCount uint32 `protobuf:"varint,7,opt,name=UtxoCount,proto3" json:"UtxoCount,omitempty"`
+}
+
+func (x *XpubAddress) Reset() {
+	*x = XpubAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XpubAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XpubAddress) ProtoMessage() {}
+
+func (x *XpubAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XpubAddress.ProtoReflect.Descriptor instead.
+func (*XpubAddress) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *XpubAddress) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *XpubAddress) GetChain() uint32 {
+	if x != nil {
+		return x.Chain
+	}
+	return 0
+}
+
+func (x *XpubAddress) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *XpubAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *XpubAddress) GetTrxCount() uint32 {
+	if x != nil {
+		return x.TrxCount
+	}
+	return 0
+}
+
+func (x *XpubAddress) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *XpubAddress) GetUtxoCount() uint32 {
+	if x != nil {
+		return x.UtxoCount
+	}
+	return 0
+}
+
+type XpubScan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScriptType         string         `protobuf:"bytes,1,opt,name=ScriptType,proto3" json:"ScriptType,omitempty"`
+	GapLimit           uint32         `protobuf:"varint,2,opt,name=GapLimit,proto3" json:"GapLimit,omitempty"`
+	Balance            int64          `protobuf:"varint,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	UtxoCount          uint32         `protobuf:"varint,4,opt,name=UtxoCount,proto3" json:"UtxoCount,omitempty"`
+	NextReceiveIndex   uint32         `protobuf:"varint,5,opt,name=NextReceiveIndex,proto3" json:"NextReceiveIndex,omitempty"`
+	NextReceiveAddress string         `protobuf:"bytes,6,opt,name=NextReceiveAddress,proto3" json:"NextReceiveAddress,omitempty"`
+	NextChangeIndex    uint32         `protobuf:"varint,7,opt,name=NextChangeIndex,proto3" json:"NextChangeIndex,omitempty"`
+	NextChangeAddress  string         `protobuf:"bytes,8,opt,name=NextChangeAddress,proto3" json:"NextChangeAddress,omitempty"`
+	Addresses          []*XpubAddress `protobuf:"bytes,9,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+	Trxs               []*TrxStatus   `protobuf:"bytes,10,rep,name=Trxs,proto3" json:"Trxs,omitempty"`
+	Utxos              []*Utxo        `protobuf:"bytes,11,rep,name=Utxos,proto3" json:"Utxos,omitempty"`
+}
+
+func (x *XpubScan) Reset() {
+	*x = XpubScan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XpubScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XpubScan) ProtoMessage() {}
+
+func (x *XpubScan) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XpubScan.ProtoReflect.Descriptor instead.
+func (*XpubScan) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *XpubScan) GetScriptType() string {
+	if x != nil {
+		return x.ScriptType
+	}
+	return ""
+}
+
+func (x *XpubScan) GetGapLimit() uint32 {
+	if x != nil {
+		return x.GapLimit
+	}
+	return 0
+}
+
+func (x *XpubScan) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *XpubScan) GetUtxoCount() uint32 {
+	if x != nil {
+		return x.UtxoCount
+	}
+	return 0
+}
+
+func (x *XpubScan) GetNextReceiveIndex() uint32 {
+	if x != nil {
+		return x.NextReceiveIndex
+	}
+	return 0
+}
+
+func (x *XpubScan) GetNextReceiveAddress() string {
+	if x != nil {
+		return x.NextReceiveAddress
+	}
+	return ""
+}
+
+func (x *XpubScan) GetNextChangeIndex() uint32 {
+	if x != nil {
+		return x.NextChangeIndex
+	}
+	return 0
+}
+
+func (x *XpubScan) GetNextChangeAddress() string {
+	if x != nil {
+		return x.NextChangeAddress
+	}
+	return ""
+}
+
+func (x *XpubScan) GetAddresses() []*XpubAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *XpubScan) GetTrxs() []*TrxStatus {
+	if x != nil {
+		return x.Trxs
+	}
+	return nil
+}
+
+func (x *XpubScan) GetUtxos() []*Utxo {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+type DescriptorScanArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Descriptor_ string `protobuf:"bytes,1,opt,name=Descriptor,proto3" json:"Descriptor,omitempty"`
+	RangeStart  uint32 `protobuf:"varint,2,opt,name=RangeStart,proto3" json:"RangeStart,omitempty"`
+	RangeEnd    uint32 `protobuf:"varint,3,opt,name=RangeEnd,proto3" json:"RangeEnd,omitempty"`
+}
+
+func (x *DescriptorScanArgs) Reset() {
+	*x = DescriptorScanArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptorScanArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptorScanArgs) ProtoMessage() {}
+
+func (x *DescriptorScanArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptorScanArgs.ProtoReflect.Descriptor instead.
+func (*DescriptorScanArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DescriptorScanArgs) GetDescriptor_() string {
+	if x != nil {
+		return x.Descriptor_
+	}
+	return ""
+}
+
+func (x *DescriptorScanArgs) GetRangeStart() uint32 {
+	if x != nil {
+		return x.RangeStart
+	}
+	return 0
+}
+
+func (x *DescriptorScanArgs) GetRangeEnd() uint32 {
+	if x != nil {
+		return x.RangeEnd
+	}
+	return 0
+}
+
+type DescriptorAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index     uint32 `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	TrxCount  uint32 `protobuf:"varint,3,opt,name=TrxCount,proto3" json:"TrxCount,omitempty"`
+	Balance   int64  `protobuf:"varint,4,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	UtxoCount uint32 `protobuf:"varint,5,opt,name=UtxoCount,proto3" json:"UtxoCount,omitempty"`
+}
+
+func (x *DescriptorAddress) Reset() {
+	*x = DescriptorAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptorAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptorAddress) ProtoMessage() {}
+
+func (x *DescriptorAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptorAddress.ProtoReflect.Descriptor instead.
+func (*DescriptorAddress) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DescriptorAddress) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DescriptorAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DescriptorAddress) GetTrxCount() uint32 {
+	if x != nil {
+		return x.TrxCount
+	}
+	return 0
+}
+
+func (x *DescriptorAddress) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *DescriptorAddress) GetUtxoCount() uint32 {
+	if x != nil {
+		return x.UtxoCount
+	}
+	return 0
+}
+
+type DescriptorScan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Descriptor_ string               `protobuf:"bytes,1,opt,name=Descriptor,proto3" json:"Descriptor,omitempty"`
+	IsRange     bool                 `protobuf:"varint,2,opt,name=IsRange,proto3" json:"IsRange,omitempty"`
+	RangeStart  uint32               `protobuf:"varint,3,opt,name=RangeStart,proto3" json:"RangeStart,omitempty"`
+	RangeEnd    uint32               `protobuf:"varint,4,opt,name=RangeEnd,proto3" json:"RangeEnd,omitempty"`
+	Balance     int64                `protobuf:"varint,5,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	UtxoCount   uint32               `protobuf:"varint,6,opt,name=UtxoCount,proto3" json:"UtxoCount,omitempty"`
+	Addresses   []*DescriptorAddress `protobuf:"bytes,7,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+	Trxs        []*TrxStatus         `protobuf:"bytes,8,rep,name=Trxs,proto3" json:"Trxs,omitempty"`
+	Utxos       []*Utxo              `protobuf:"bytes,9,rep,name=Utxos,proto3" json:"Utxos,omitempty"`
+}
+
+func (x *DescriptorScan) Reset() {
+	*x = DescriptorScan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptorScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptorScan) ProtoMessage() {}
+
+func (x *DescriptorScan) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptorScan.ProtoReflect.Descriptor instead.
+func (*DescriptorScan) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DescriptorScan) GetDescriptor_() string {
+	if x != nil {
+		return x.Descriptor_
+	}
+	return ""
+}
+
+func (x *DescriptorScan) GetIsRange() bool {
+	if x != nil {
+		return x.IsRange
+	}
+	return false
+}
+
+func (x *DescriptorScan) GetRangeStart() uint32 {
+	if x != nil {
+		return x.RangeStart
+	}
+	return 0
+}
+
+func (x *DescriptorScan) GetRangeEnd() uint32 {
+	if x != nil {
+		return x.RangeEnd
+	}
+	return 0
+}
+
+func (x *DescriptorScan) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *DescriptorScan) GetUtxoCount() uint32 {
+	if x != nil {
+		return x.UtxoCount
+	}
+	return 0
+}
+
+func (x *DescriptorScan) GetAddresses() []*DescriptorAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *DescriptorScan) GetTrxs() []*TrxStatus {
+	if x != nil {
+		return x.Trxs
+	}
+	return nil
+}
+
+func (x *DescriptorScan) GetUtxos() []*Utxo {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+type RawTrxArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RawTrx string `protobuf:"bytes,1,opt,name=RawTrx,proto3" json:"RawTrx,omitempty"`
+}
+
+func (x *RawTrxArgs) Reset() {
+	*x = RawTrxArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawTrxArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawTrxArgs) ProtoMessage() {}
+
+func (x *RawTrxArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawTrxArgs.ProtoReflect.Descriptor instead.
+func (*RawTrxArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RawTrxArgs) GetRawTrx() string {
+	if x != nil {
+		return x.RawTrx
+	}
+	return ""
+}
+
+type SendRawTrxReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxId         string        `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	Accepted      bool          `protobuf:"varint,2,opt,name=Accepted,proto3" json:"Accepted,omitempty"`
+	RejectCode    string        `protobuf:"bytes,3,opt,name=RejectCode,proto3" json:"RejectCode,omitempty"`
+	RejectReason  string        `protobuf:"bytes,4,opt,name=RejectReason,proto3" json:"RejectReason,omitempty"`
+	MissingInputs []*UtxoSource `protobuf:"bytes,5,rep,name=MissingInputs,proto3" json:"MissingInputs,omitempty"`
+	ValueIn       int64         `protobuf:"varint,6,opt,name=ValueIn,proto3" json:"ValueIn,omitempty"`
+	ValueOut      int64         `protobuf:"varint,7,opt,name=ValueOut,proto3" json:"ValueOut,omitempty"`
+	Fee           int64         `protobuf:"varint,8,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	VSize         int64         `protobuf:"varint,9,opt,name=VSize,proto3" json:"VSize,omitempty"`
+	FeeRate       float64       `protobuf:"fixed64,10,opt,name=FeeRate,proto3" json:"FeeRate,omitempty"`
+}
+
+func (x *SendRawTrxReply) Reset() {
+	*x = SendRawTrxReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendRawTrxReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendRawTrxReply) ProtoMessage() {}
+
+func (x *SendRawTrxReply) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendRawTrxReply.ProtoReflect.Descriptor instead.
+func (*SendRawTrxReply) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SendRawTrxReply) GetTrxId() string {
+	if x != nil {
+		return x.TrxId
+	}
+	return ""
+}
+
+func (x *SendRawTrxReply) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *SendRawTrxReply) GetRejectCode() string {
+	if x != nil {
+		return x.RejectCode
+	}
+	return ""
+}
+
+func (x *SendRawTrxReply) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *SendRawTrxReply) GetMissingInputs() []*UtxoSource {
+	if x != nil {
+		return x.MissingInputs
+	}
+	return nil
+}
+
+func (x *SendRawTrxReply) GetValueIn() int64 {
+	if x != nil {
+		return x.ValueIn
+	}
+	return 0
+}
+
+func (x *SendRawTrxReply) GetValueOut() int64 {
+	if x != nil {
+		return x.ValueOut
+	}
+	return 0
+}
+
+func (x *SendRawTrxReply) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *SendRawTrxReply) GetVSize() int64 {
+	if x != nil {
+		return x.VSize
+	}
+	return 0
+}
+
+func (x *SendRawTrxReply) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+type EstimateFeeArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetBlocks uint32 `protobuf:"varint,1,opt,name=TargetBlocks,proto3" json:"TargetBlocks,omitempty"`
+}
+
+func (x *EstimateFeeArgs) Reset() {
+	*x = EstimateFeeArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateFeeArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateFeeArgs) ProtoMessage() {}
+
+func (x *EstimateFeeArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateFeeArgs.ProtoReflect.Descriptor instead.
+func (*EstimateFeeArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EstimateFeeArgs) GetTargetBlocks() uint32 {
+	if x != nil {
+		return x.TargetBlocks
+	}
+	return 0
+}
+
+type FeeEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetBlocks uint32  `protobuf:"varint,1,opt,name=TargetBlocks,proto3" json:"TargetBlocks,omitempty"`
+	FeeRate      float64 `protobuf:"fixed64,2,opt,name=FeeRate,proto3" json:"FeeRate,omitempty"`
+	BlocksUsed   uint32  `protobuf:"varint,3,opt,name=BlocksUsed,proto3" json:"BlocksUsed,omitempty"`
+	StartHeight  uint32  `protobuf:"varint,4,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"`
+	EndHeight    uint32  `protobuf:"varint,5,opt,name=EndHeight,proto3" json:"EndHeight,omitempty"`
+}
+
+func (x *FeeEstimate) Reset() {
+	*x = FeeEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeEstimate) ProtoMessage() {}
+
+func (x *FeeEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeEstimate.ProtoReflect.Descriptor instead.
+func (*FeeEstimate) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FeeEstimate) GetTargetBlocks() uint32 {
+	if x != nil {
+		return x.TargetBlocks
+	}
+	return 0
+}
+
+func (x *FeeEstimate) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *FeeEstimate) GetBlocksUsed() uint32 {
+	if x != nil {
+		return x.BlocksUsed
+	}
+	return 0
+}
+
+func (x *FeeEstimate) GetStartHeight() uint32 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *FeeEstimate) GetEndHeight() uint32 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+type BlockFeeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight     uint32  `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	BlockHash       string  `protobuf:"bytes,2,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	TrxCount        uint32  `protobuf:"varint,3,opt,name=TrxCount,proto3" json:"TrxCount,omitempty"`
+	TotalVSize      uint64  `protobuf:"varint,4,opt,name=TotalVSize,proto3" json:"TotalVSize,omitempty"`
+	TotalFee        int64   `protobuf:"varint,5,opt,name=TotalFee,proto3" json:"TotalFee,omitempty"`
+	MinFeeRate      float64 `protobuf:"fixed64,6,opt,name=MinFeeRate,proto3" json:"MinFeeRate,omitempty"`
+	MaxFeeRate      float64 `protobuf:"fixed64,7,opt,name=MaxFeeRate,proto3" json:"MaxFeeRate,omitempty"`
+	AvgFeeRate      float64 `protobuf:"fixed64,8,opt,name=AvgFeeRate,proto3" json:"AvgFeeRate,omitempty"`
+	FeeRate10Th     float64 `protobuf:"fixed64,9,opt,name=FeeRate10th,proto3" json:"FeeRate10th,omitempty"`
+	FeeRate25Th     float64 `protobuf:"fixed64,10,opt,name=FeeRate25th,proto3" json:"FeeRate25th,omitempty"`
+	FeeRate50Th     float64 `protobuf:"fixed64,11,opt,name=FeeRate50th,proto3" json:"FeeRate50th,omitempty"`
+	FeeRate75Th     float64 `protobuf:"fixed64,12,opt,name=FeeRate75th,proto3" json:"FeeRate75th,omitempty"`
+	FeeRate90Th     float64 `protobuf:"fixed64,13,opt,name=FeeRate90th,proto3" json:"FeeRate90th,omitempty"`
+	FeeRateUnitDesc string  `protobuf:"bytes,14,opt,name=FeeRateUnitDesc,proto3" json:"FeeRateUnitDesc,omitempty"`
+}
+
+func (x *BlockFeeStats) Reset() {
+	*x = BlockFeeStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockFeeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockFeeStats) ProtoMessage() {}
+
+func (x *BlockFeeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockFeeStats.ProtoReflect.Descriptor instead.
+func (*BlockFeeStats) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BlockFeeStats) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *BlockFeeStats) GetTrxCount() uint32 {
+	if x != nil {
+		return x.TrxCount
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetTotalVSize() uint64 {
+	if x != nil {
+		return x.TotalVSize
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetTotalFee() int64 {
+	if x != nil {
+		return x.TotalFee
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetMinFeeRate() float64 {
+	if x != nil {
+		return x.MinFeeRate
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetMaxFeeRate() float64 {
+	if x != nil {
+		return x.MaxFeeRate
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetAvgFeeRate() float64 {
+	if x != nil {
+		return x.AvgFeeRate
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetFeeRate10Th() float64 {
+	if x != nil {
+		return x.FeeRate10Th
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetFeeRate25Th() float64 {
+	if x != nil {
+		return x.FeeRate25Th
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetFeeRate50Th() float64 {
+	if x != nil {
+		return x.FeeRate50Th
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetFeeRate75Th() float64 {
+	if x != nil {
+		return x.FeeRate75Th
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetFeeRate90Th() float64 {
+	if x != nil {
+		return x.FeeRate90Th
+	}
+	return 0
+}
+
+func (x *BlockFeeStats) GetFeeRateUnitDesc() string {
+	if x != nil {
+		return x.FeeRateUnitDesc
+	}
+	return ""
+}
+
+type PsbtOutputArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Amount  int64  `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+}
+
+func (x *PsbtOutputArgs) Reset() {
+	*x = PsbtOutputArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PsbtOutputArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PsbtOutputArgs) ProtoMessage() {}
+
+func (x *PsbtOutputArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PsbtOutputArgs.ProtoReflect.Descriptor instead.
+func (*PsbtOutputArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PsbtOutputArgs) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PsbtOutputArgs) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CreatePsbtArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromAddresses  []string          `protobuf:"bytes,1,rep,name=FromAddresses,proto3" json:"FromAddresses,omitempty"`
+	Outputs        []*PsbtOutputArgs `protobuf:"bytes,2,rep,name=Outputs,proto3" json:"Outputs,omitempty"`
+	FeeRate        float64           `protobuf:"fixed64,3,opt,name=FeeRate,proto3" json:"FeeRate,omitempty"`
+	ChangeAddress  string            `protobuf:"bytes,4,opt,name=ChangeAddress,proto3" json:"ChangeAddress,omitempty"`
+	IncludeLocked  bool              `protobuf:"varint,5,opt,name=IncludeLocked,proto3" json:"IncludeLocked,omitempty"`
+	P2ShScriptType string            `protobuf:"bytes,6,opt,name=P2shScriptType,proto3" json:"P2shScriptType,omitempty"`
+}
+
+func (x *CreatePsbtArgs) Reset() {
+	*x = CreatePsbtArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePsbtArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePsbtArgs) ProtoMessage() {}
+
+func (x *CreatePsbtArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePsbtArgs.ProtoReflect.Descriptor instead.
+func (*CreatePsbtArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreatePsbtArgs) GetFromAddresses() []string {
+	if x != nil {
+		return x.FromAddresses
+	}
+	return nil
+}
+
+func (x *CreatePsbtArgs) GetOutputs() []*PsbtOutputArgs {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *CreatePsbtArgs) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *CreatePsbtArgs) GetChangeAddress() string {
+	if x != nil {
+		return x.ChangeAddress
+	}
+	return ""
+}
+
+func (x *CreatePsbtArgs) GetIncludeLocked() bool {
+	if x != nil {
+		return x.IncludeLocked
+	}
+	return false
+}
+
+func (x *CreatePsbtArgs) GetP2ShScriptType() string {
+	if x != nil {
+		return x.P2ShScriptType
+	}
+	return ""
+}
+
+type CreatePsbtReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Psbt           string  `protobuf:"bytes,1,opt,name=Psbt,proto3" json:"Psbt,omitempty"`
+	Algorithm      string  `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	FeeRate        float64 `protobuf:"fixed64,3,opt,name=FeeRate,proto3" json:"FeeRate,omitempty"`
+	Fee            int64   `protobuf:"varint,4,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	VSize          int64   `protobuf:"varint,5,opt,name=VSize,proto3" json:"VSize,omitempty"`
+	ChangePosition int64   `protobuf:"varint,6,opt,name=ChangePosition,proto3" json:"ChangePosition,omitempty"`
+	ChangeAmount   int64   `protobuf:"varint,7,opt,name=ChangeAmount,proto3" json:"ChangeAmount,omitempty"`
+	Inputs         []*Utxo `protobuf:"bytes,8,rep,name=Inputs,proto3" json:"Inputs,omitempty"`
+}
+
+func (x *CreatePsbtReply) Reset() {
+	*x = CreatePsbtReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePsbtReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePsbtReply) ProtoMessage() {}
+
+func (x *CreatePsbtReply) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePsbtReply.ProtoReflect.Descriptor instead.
+func (*CreatePsbtReply) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreatePsbtReply) GetPsbt() string {
+	if x != nil {
+		return x.Psbt
+	}
+	return ""
+}
+
+func (x *CreatePsbtReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *CreatePsbtReply) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *CreatePsbtReply) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *CreatePsbtReply) GetVSize() int64 {
+	if x != nil {
+		return x.VSize
+	}
+	return 0
+}
+
+func (x *CreatePsbtReply) GetChangePosition() int64 {
+	if x != nil {
+		return x.ChangePosition
+	}
+	return 0
+}
+
+func (x *CreatePsbtReply) GetChangeAmount() int64 {
+	if x != nil {
+		return x.ChangeAmount
+	}
+	return 0
+}
+
+func (x *CreatePsbtReply) GetInputs() []*Utxo {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+type LockUnspentArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Utxos []*UtxoSource `protobuf:"bytes,1,rep,name=Utxos,proto3" json:"Utxos,omitempty"`
+	TTL   uint32        `protobuf:"varint,2,opt,name=TTL,proto3" json:"TTL,omitempty"`
+	Label string        `protobuf:"bytes,3,opt,name=Label,proto3" json:"Label,omitempty"`
+	Owner string        `protobuf:"bytes,4,opt,name=Owner,proto3" json:"Owner,omitempty"`
+}
+
+func (x *LockUnspentArgs) Reset() {
+	*x = LockUnspentArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockUnspentArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockUnspentArgs) ProtoMessage() {}
+
+func (x *LockUnspentArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockUnspentArgs.ProtoReflect.Descriptor instead.
+func (*LockUnspentArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LockUnspentArgs) GetUtxos() []*UtxoSource {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+func (x *LockUnspentArgs) GetTTL() uint32 {
+	if x != nil {
+		return x.TTL
+	}
+	return 0
+}
+
+func (x *LockUnspentArgs) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *LockUnspentArgs) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type LockUnspentReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner string      `protobuf:"bytes,1,opt,name=Owner,proto3" json:"Owner,omitempty"`
+	Locks []*UtxoLock `protobuf:"bytes,2,rep,name=Locks,proto3" json:"Locks,omitempty"`
+}
+
+func (x *LockUnspentReply) Reset() {
+	*x = LockUnspentReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockUnspentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockUnspentReply) ProtoMessage() {}
+
+func (x *LockUnspentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockUnspentReply.ProtoReflect.Descriptor instead.
+func (*LockUnspentReply) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LockUnspentReply) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LockUnspentReply) GetLocks() []*UtxoLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type UnlockUnspentArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Utxos []*UtxoSource `protobuf:"bytes,1,rep,name=Utxos,proto3" json:"Utxos,omitempty"`
+	Owner string        `protobuf:"bytes,2,opt,name=Owner,proto3" json:"Owner,omitempty"`
+}
+
+func (x *UnlockUnspentArgs) Reset() {
+	*x = UnlockUnspentArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockUnspentArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUnspentArgs) ProtoMessage() {}
+
+func (x *UnlockUnspentArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUnspentArgs.ProtoReflect.Descriptor instead.
+func (*UnlockUnspentArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UnlockUnspentArgs) GetUtxos() []*UtxoSource {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+func (x *UnlockUnspentArgs) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type UtxoLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxId      string `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	Vout       uint32 `protobuf:"varint,2,opt,name=Vout,proto3" json:"Vout,omitempty"`
+	Label      string `protobuf:"bytes,3,opt,name=Label,proto3" json:"Label,omitempty"`
+	LockTime   int64  `protobuf:"varint,4,opt,name=LockTime,proto3" json:"LockTime,omitempty"`
+	ExpireTime int64  `protobuf:"varint,5,opt,name=ExpireTime,proto3" json:"ExpireTime,omitempty"`
+}
+
+func (x *UtxoLock) Reset() {
+	*x = UtxoLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoLock) ProtoMessage() {}
+
+func (x *UtxoLock) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoLock.ProtoReflect.Descriptor instead.
+func (*UtxoLock) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UtxoLock) GetTrxId() string {
+	if x != nil {
+		return x.TrxId
+	}
+	return ""
+}
+
+func (x *UtxoLock) GetVout() uint32 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+func (x *UtxoLock) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UtxoLock) GetLockTime() int64 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
+func (x *UtxoLock) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type UtxoLockList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locks []*UtxoLock `protobuf:"bytes,1,rep,name=Locks,proto3" json:"Locks,omitempty"`
+}
+
+func (x *UtxoLockList) Reset() {
+	*x = UtxoLockList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoLockList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoLockList) ProtoMessage() {}
+
+func (x *UtxoLockList) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoLockList.ProtoReflect.Descriptor instead.
+func (*UtxoLockList) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UtxoLockList) GetLocks() []*UtxoLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type UtxoScriptTypeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScriptType  string `protobuf:"bytes,1,opt,name=ScriptType,proto3" json:"ScriptType,omitempty"`
+	UtxoCount   uint64 `protobuf:"varint,2,opt,name=UtxoCount,proto3" json:"UtxoCount,omitempty"`
+	TotalAmount int64  `protobuf:"varint,3,opt,name=TotalAmount,proto3" json:"TotalAmount,omitempty"`
+}
+
+func (x *UtxoScriptTypeInfo) Reset() {
+	*x = UtxoScriptTypeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoScriptTypeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoScriptTypeInfo) ProtoMessage() {}
+
+func (x *UtxoScriptTypeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoScriptTypeInfo.ProtoReflect.Descriptor instead.
+func (*UtxoScriptTypeInfo) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UtxoScriptTypeInfo) GetScriptType() string {
+	if x != nil {
+		return x.ScriptType
+	}
+	return ""
+}
+
+func (x *UtxoScriptTypeInfo) GetUtxoCount() uint64 {
+	if x != nil {
+		return x.UtxoCount
+	}
+	return 0
+}
+
+func (x *UtxoScriptTypeInfo) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+type UtxoSetInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint32                `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	UtxoCount   uint64                `protobuf:"varint,2,opt,name=UtxoCount,proto3" json:"UtxoCount,omitempty"`
+	TotalAmount int64                 `protobuf:"varint,3,opt,name=TotalAmount,proto3" json:"TotalAmount,omitempty"`
+	MuHash      string                `protobuf:"bytes,4,opt,name=MuHash,proto3" json:"MuHash,omitempty"`
+	ScriptTypes []*UtxoScriptTypeInfo `protobuf:"bytes,5,rep,name=ScriptTypes,proto3" json:"ScriptTypes,omitempty"`
+}
+
+func (x *UtxoSetInfo) Reset() {
+	*x = UtxoSetInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoSetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoSetInfo) ProtoMessage() {}
+
+func (x *UtxoSetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoSetInfo.ProtoReflect.Descriptor instead.
+func (*UtxoSetInfo) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UtxoSetInfo) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *UtxoSetInfo) GetUtxoCount() uint64 {
+	if x != nil {
+		return x.UtxoCount
+	}
+	return 0
+}
+
+func (x *UtxoSetInfo) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *UtxoSetInfo) GetMuHash() string {
+	if x != nil {
+		return x.MuHash
+	}
+	return ""
+}
+
+func (x *UtxoSetInfo) GetScriptTypes() []*UtxoScriptTypeInfo {
+	if x != nil {
+		return x.ScriptTypes
+	}
+	return nil
+}
+
+type BackupArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DstDir string `protobuf:"bytes,1,opt,name=DstDir,proto3" json:"DstDir,omitempty"`
+}
+
+func (x *BackupArgs) Reset() {
+	*x = BackupArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupArgs) ProtoMessage() {}
+
+func (x *BackupArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupArgs.ProtoReflect.Descriptor instead.
+func (*BackupArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BackupArgs) GetDstDir() string {
+	if x != nil {
+		return x.DstDir
+	}
+	return ""
+}
+
+type BackupManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint32   `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	TrxSequence uint32   `protobuf:"varint,2,opt,name=TrxSequence,proto3" json:"TrxSequence,omitempty"`
+	DbType      string   `protobuf:"bytes,3,opt,name=DbType,proto3" json:"DbType,omitempty"`
+	BackupTime  int64    `protobuf:"varint,4,opt,name=BackupTime,proto3" json:"BackupTime,omitempty"`
+	DBNames     []string `protobuf:"bytes,5,rep,name=DBNames,proto3" json:"DBNames,omitempty"`
+}
+
+func (x *BackupManifest) Reset() {
+	*x = BackupManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupManifest) ProtoMessage() {}
+
+func (x *BackupManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupManifest.ProtoReflect.Descriptor instead.
+func (*BackupManifest) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BackupManifest) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *BackupManifest) GetTrxSequence() uint32 {
+	if x != nil {
+		return x.TrxSequence
+	}
+	return 0
+}
+
+func (x *BackupManifest) GetDbType() string {
+	if x != nil {
+		return x.DbType
+	}
+	return ""
+}
+
+func (x *BackupManifest) GetBackupTime() int64 {
+	if x != nil {
+		return x.BackupTime
+	}
+	return 0
+}
+
+func (x *BackupManifest) GetDBNames() []string {
+	if x != nil {
+		return x.DBNames
+	}
+	return nil
+}
+
+type VerifyArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromHeight uint32 `protobuf:"varint,1,opt,name=FromHeight,proto3" json:"FromHeight,omitempty"`
+	ToHeight   uint32 `protobuf:"varint,2,opt,name=ToHeight,proto3" json:"ToHeight,omitempty"`
+}
+
+func (x *VerifyArgs) Reset() {
+	*x = VerifyArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyArgs) ProtoMessage() {}
+
+func (x *VerifyArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyArgs.ProtoReflect.Descriptor instead.
+func (*VerifyArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *VerifyArgs) GetFromHeight() uint32 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *VerifyArgs) GetToHeight() uint32 {
+	if x != nil {
+		return x.ToHeight
+	}
+	return 0
+}
+
+type VerifyReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight     uint32   `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	TrxSequence     uint32   `protobuf:"varint,2,opt,name=TrxSequence,proto3" json:"TrxSequence,omitempty"`
+	TrxSeqCount     uint32   `protobuf:"varint,3,opt,name=TrxSeqCount,proto3" json:"TrxSeqCount,omitempty"`
+	AddrEntryCount  uint64   `protobuf:"varint,4,opt,name=AddrEntryCount,proto3" json:"AddrEntryCount,omitempty"`
+	UtxoCount       uint64   `protobuf:"varint,5,opt,name=UtxoCount,proto3" json:"UtxoCount,omitempty"`
+	UtxoTotalAmount int64    `protobuf:"varint,6,opt,name=UtxoTotalAmount,proto3" json:"UtxoTotalAmount,omitempty"`
+	ExpectIssuance  int64    `protobuf:"varint,7,opt,name=ExpectIssuance,proto3" json:"ExpectIssuance,omitempty"`
+	BlocksVerified  uint32   `protobuf:"varint,8,opt,name=BlocksVerified,proto3" json:"BlocksVerified,omitempty"`
+	ProblemCount    uint64   `protobuf:"varint,9,opt,name=ProblemCount,proto3" json:"ProblemCount,omitempty"`
+	Problems        []string `protobuf:"bytes,10,rep,name=Problems,proto3" json:"Problems,omitempty"`
+	ChainIndexState string   `protobuf:"bytes,11,opt,name=ChainIndexState,proto3" json:"ChainIndexState,omitempty"`
+}
+
+func (x *VerifyReport) Reset() {
+	*x = VerifyReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyReport) ProtoMessage() {}
+
+func (x *VerifyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyReport.ProtoReflect.Descriptor instead.
+func (*VerifyReport) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *VerifyReport) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *VerifyReport) GetTrxSequence() uint32 {
+	if x != nil {
+		return x.TrxSequence
+	}
+	return 0
+}
+
+func (x *VerifyReport) GetTrxSeqCount() uint32 {
+	if x != nil {
+		return x.TrxSeqCount
+	}
+	return 0
+}
+
+func (x *VerifyReport) GetAddrEntryCount() uint64 {
+	if x != nil {
+		return x.AddrEntryCount
+	}
+	return 0
+}
+
+func (x *VerifyReport) GetUtxoCount() uint64 {
+	if x != nil {
+		return x.UtxoCount
+	}
+	return 0
+}
+
+func (x *VerifyReport) GetUtxoTotalAmount() int64 {
+	if x != nil {
+		return x.UtxoTotalAmount
+	}
+	return 0
+}
+
+func (x *VerifyReport) GetExpectIssuance() int64 {
+	if x != nil {
+		return x.ExpectIssuance
+	}
+	return 0
+}
+
+func (x *VerifyReport) GetBlocksVerified() uint32 {
+	if x != nil {
+		return x.BlocksVerified
+	}
+	return 0
+}
+
+func (x *VerifyReport) GetProblemCount() uint64 {
+	if x != nil {
+		return x.ProblemCount
+	}
+	return 0
+}
+
+func (x *VerifyReport) GetProblems() []string {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *VerifyReport) GetChainIndexState() string {
+	if x != nil {
+		return x.ChainIndexState
+	}
+	return ""
+}
+
+type SubscribeBlocksArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromHeight uint32 `protobuf:"varint,1,opt,name=FromHeight,proto3" json:"FromHeight,omitempty"`
+}
+
+func (x *SubscribeBlocksArgs) Reset() {
+	*x = SubscribeBlocksArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeBlocksArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBlocksArgs) ProtoMessage() {}
+
+func (x *SubscribeBlocksArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBlocksArgs.ProtoReflect.Descriptor instead.
+func (*SubscribeBlocksArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SubscribeBlocksArgs) GetFromHeight() uint32 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+type SubscribeTrxsArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (x *SubscribeTrxsArgs) Reset() {
+	*x = SubscribeTrxsArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeTrxsArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeTrxsArgs) ProtoMessage() {}
+
+func (x *SubscribeTrxsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeTrxsArgs.ProtoReflect.Descriptor instead.
+func (*SubscribeTrxsArgs) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SubscribeTrxsArgs) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type TrxNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrxId         string   `protobuf:"bytes,1,opt,name=TrxId,proto3" json:"TrxId,omitempty"`
+	TrxSeq        uint32   `protobuf:"varint,2,opt,name=TrxSeq,proto3" json:"TrxSeq,omitempty"`
+	BlockHeight   uint32   `protobuf:"varint,3,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	BlockIndex    uint32   `protobuf:"varint,4,opt,name=BlockIndex,proto3" json:"BlockIndex,omitempty"`
+	BlockHash     string   `protobuf:"bytes,5,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Confirmations uint32   `protobuf:"varint,6,opt,name=Confirmations,proto3" json:"Confirmations,omitempty"`
+	Addresses     []string `protobuf:"bytes,7,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (x *TrxNotification) Reset() {
+	*x = TrxNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spv_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrxNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrxNotification) ProtoMessage() {}
+
+func (x *TrxNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_spv_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrxNotification.ProtoReflect.Descriptor instead.
+func (*TrxNotification) Descriptor() ([]byte, []int) {
+	return file_spv_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TrxNotification) GetTrxId() string {
+	if x != nil {
+		return x.TrxId
+	}
+	return ""
+}
+
+func (x *TrxNotification) GetTrxSeq() uint32 {
+	if x != nil {
+		return x.TrxSeq
+	}
+	return 0
+}
+
+func (x *TrxNotification) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *TrxNotification) GetBlockIndex() uint32 {
+	if x != nil {
+		return x.BlockIndex
+	}
+	return 0
+}
+
+func (x *TrxNotification) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *TrxNotification) GetConfirmations() uint32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *TrxNotification) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+var File_spv_proto protoreflect.FileDescriptor
+
+var file_spv_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x73, 0x70, 0x76, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x73, 0x70, 0x76,
+	0x22, 0x0b, 0x0a, 0x09, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x22, 0x22, 0x0a,
+	0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x24, 0x0a, 0x0a, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x41, 0x72, 0x67, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x22, 0x21, 0x0a, 0x09, 0x54, 0x72, 0x78, 0x49, 0x64,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x22, 0x22, 0x0a, 0x0a, 0x54, 0x72,
+	0x78, 0x49, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x22, 0x27,
+	0x0a, 0x0b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xbf, 0x01, 0x0a, 0x09,
+	0x54, 0x72, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x33, 0x0a,
+	0x0d, 0x54, 0x72, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x04, 0x54, 0x72, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73,
+	0x70, 0x76, 0x2e, 0x54, 0x72, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x54, 0x72,
+	0x78, 0x73, 0x22, 0x60, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x4f, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa1, 0x03, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x20,
+	0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x76,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x50, 0x72, 0x65, 0x76, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x42, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x42, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x72, 0x78, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x72, 0x78, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x75, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x75, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x54, 0x72, 0x78, 0x49, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x54, 0x72, 0x78, 0x49, 0x64, 0x73, 0x22, 0x25, 0x0a, 0x0b, 0x52, 0x61, 0x77, 0x54,
+	0x72, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x61, 0x77, 0x54, 0x72,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x61, 0x77, 0x54, 0x72, 0x78, 0x22,
+	0x2c, 0x0a, 0x08, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x0c, 0x0a, 0x01, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x4e, 0x22, 0x8f, 0x01,
+	0x0a, 0x04, 0x54, 0x78, 0x49, 0x6e, 0x12, 0x27, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x76, 0x4f, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x4f, 0x75,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x50, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x22,
+	0x7b, 0x0a, 0x05, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb6, 0x01, 0x0a,
+	0x03, 0x54, 0x72, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x76,
+	0x2e, 0x54, 0x72, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1b, 0x0a, 0x03, 0x56, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x78, 0x49, 0x6e, 0x52, 0x03, 0x56, 0x69, 0x6e, 0x12,
+	0x1e, 0x0a, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x73, 0x70, 0x76, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x52, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8a, 0x02, 0x0a, 0x0b, 0x54, 0x78, 0x49, 0x6e, 0x56, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x4f, 0x75, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x50, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0xb2, 0x02, 0x0a, 0x0a, 0x54, 0x72, 0x78, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x72,
+	0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x22, 0x0a, 0x03, 0x56, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
+	0x70, 0x76, 0x2e, 0x54, 0x78, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x52, 0x03,
+	0x56, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x52, 0x04, 0x56,
+	0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x56, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x46,
+	0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x36, 0x0a, 0x0a, 0x55, 0x74, 0x78, 0x6f, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x56,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x22,
+	0x37, 0x0a, 0x0e, 0x55, 0x74, 0x78, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x05, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x05, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0a, 0x55, 0x74, 0x78,
+	0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22,
+	0x37, 0x0a, 0x0e, 0x55, 0x74, 0x78, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x05, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x52, 0x05, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x04, 0x55, 0x74, 0x78,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x22, 0x41, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72,
+	0x78, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x54, 0x72, 0x78, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x54, 0x72, 0x78, 0x49, 0x64, 0x73, 0x22, 0x65, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x54, 0x72, 0x78, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x76,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x78, 0x49, 0x64, 0x73, 0x52, 0x09,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x54, 0x72, 0x78,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x72,
+	0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x54, 0x72, 0x78, 0x73, 0x22, 0x49, 0x0a,
+	0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x55, 0x74, 0x78, 0x6f, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78,
+	0x6f, 0x52, 0x05, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x43, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x09,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x74, 0x78,
+	0x6f, 0x73, 0x52, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x7e, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x72, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x72, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7d, 0x0a,
+	0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x55,
+	0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
+	0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x0c,
+	0x58, 0x70, 0x75, 0x62, 0x53, 0x63, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x58, 0x70, 0x75, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x58, 0x70, 0x75, 0x62,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x47, 0x61, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x47, 0x61, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xbb, 0x01, 0x0a,
+	0x0b, 0x58, 0x70, 0x75, 0x62, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x72, 0x78, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x72, 0x78, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa7, 0x03, 0x0a, 0x08, 0x58,
+	0x70, 0x75, 0x62, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x61, 0x70, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x47, 0x61, 0x70, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x4e,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x12, 0x4e, 0x65, 0x78, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x4e, 0x65, 0x78, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x2c, 0x0a, 0x11, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x4e, 0x65,
+	0x78, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2e, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x58, 0x70, 0x75, 0x62, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x04, 0x54, 0x72, 0x78, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x73, 0x70, 0x76, 0x2e, 0x54, 0x72, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x54,
+	0x72, 0x78, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x22, 0x70, 0x0a, 0x12, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x53, 0x63, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x45, 0x6e, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x54, 0x72, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x54, 0x72, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xb9, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x53,
+	0x63, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x34, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x54, 0x72, 0x78, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x72, 0x78, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x54, 0x72, 0x78, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x73, 0x70, 0x76,
+	0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x24, 0x0a, 0x0a,
+	0x52, 0x61, 0x77, 0x54, 0x72, 0x78, 0x41, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x61,
+	0x77, 0x54, 0x72, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x61, 0x77, 0x54,
+	0x72, 0x78, 0x22, 0xb6, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x61, 0x77, 0x54, 0x72,
+	0x78, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0d,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x0d, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x46, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x56,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x35, 0x0a, 0x0f, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x55, 0x73, 0x65, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0xdb, 0x03, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x72, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x54, 0x72, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x69,
+	0x6e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61,
+	0x78, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x4d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x76,
+	0x67, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x41, 0x76, 0x67, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x65,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x31, 0x30, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x31, 0x30, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b,
+	0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x32, 0x35, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x32, 0x35, 0x74, 0x68, 0x12, 0x20,
+	0x0a, 0x0b, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x35, 0x30, 0x74, 0x68, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x35, 0x30, 0x74, 0x68,
+	0x12, 0x20, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x37, 0x35, 0x74, 0x68, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x37, 0x35,
+	0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x39, 0x30, 0x74,
+	0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x39, 0x30, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x55,
+	0x6e, 0x69, 0x74, 0x44, 0x65, 0x73, 0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x46,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x44, 0x65, 0x73, 0x63, 0x22, 0x42,
+	0x0a, 0x0e, 0x50, 0x73, 0x62, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xf3, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x62,
+	0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x46, 0x72,
+	0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
+	0x70, 0x76, 0x2e, 0x50, 0x73, 0x62, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67,
+	0x73, 0x52, 0x07, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x65,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x46, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x50, 0x32, 0x73, 0x68, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x32, 0x73, 0x68, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x50, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x73, 0x62, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x18,
+	0x0a, 0x07, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x46, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x06,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x73,
+	0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x06, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22,
+	0x76, 0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x72,
+	0x67, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x05, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x54, 0x4c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x54, 0x54, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x6b, 0x55,
+	0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
+	0x05, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x50, 0x0a, 0x11, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x76,
+	0x2e, 0x55, 0x74, 0x78, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x55, 0x74, 0x78,
+	0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x08, 0x55, 0x74, 0x78,
+	0x6f, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x56,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x56, 0x6f, 0x75, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x33, 0x0a, 0x0c, 0x55, 0x74, 0x78, 0x6f, 0x4c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
+	0x05, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x74, 0x0a, 0x12, 0x55, 0x74, 0x78, 0x6f, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc2, 0x01, 0x0a,
+	0x0b, 0x55, 0x74, 0x78, 0x6f, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x4d, 0x75, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x4d, 0x75, 0x48, 0x61, 0x73, 0x68, 0x12, 0x39, 0x0a, 0x0b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70,
+	0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x22, 0x24, 0x0a, 0x0a, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x72, 0x67, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x44, 0x73, 0x74, 0x44, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x44, 0x73, 0x74, 0x44, 0x69, 0x72, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x44, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x44, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x42, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x44, 0x42, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x22, 0x48, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x54, 0x6f, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x54, 0x6f, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x9e, 0x03, 0x0a, 0x0c, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x74, 0x78,
+	0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x55, 0x74,
+	0x78, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x74, 0x78, 0x6f, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x55, 0x74, 0x78, 0x6f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x45, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x35, 0x0a, 0x13, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x41, 0x72,
+	0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x31, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x72, 0x78, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xe3, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x78, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x78,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x78, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x32, 0xa1, 0x0c, 0x0a, 0x0a,
+	0x53, 0x70, 0x76, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x73, 0x70,
+	0x76, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0f, 0x2e, 0x73, 0x70,
+	0x76, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x73, 0x70,
+	0x76, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0f, 0x2e, 0x73, 0x70,
+	0x76, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x78, 0x49, 0x64, 0x42, 0x79, 0x53, 0x65, 0x71, 0x12, 0x0f, 0x2e,
+	0x73, 0x70, 0x76, 0x2e, 0x54, 0x72, 0x78, 0x53, 0x65, 0x71, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0f,
+	0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x72, 0x78, 0x49, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x78,
+	0x73, 0x12, 0x10, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x12, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x72, 0x78, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x72,
+	0x78, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0e, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x72,
+	0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0a, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x54, 0x72,
+	0x78, 0x12, 0x0e, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x72, 0x78, 0x49, 0x64, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x10, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x78, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x54, 0x72, 0x78, 0x12, 0x0e, 0x2e,
+	0x73, 0x70, 0x76, 0x2e, 0x54, 0x72, 0x78, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x08, 0x2e,
+	0x73, 0x70, 0x76, 0x2e, 0x54, 0x72, 0x78, 0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x78, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x0e, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54,
+	0x72, 0x78, 0x49, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54,
+	0x72, 0x78, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x55, 0x74, 0x78, 0x6f, 0x12, 0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x34, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x53, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x13, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74,
+	0x78, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x54, 0x72, 0x78, 0x73,
+	0x12, 0x12, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x41, 0x72, 0x67, 0x73, 0x1a, 0x12, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x54, 0x72, 0x78, 0x73, 0x12, 0x3d, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x6e, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x12, 0x12, 0x2e, 0x73,
+	0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73,
+	0x1a, 0x15, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12,
+	0x2e, 0x73, 0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x15, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x53, 0x63, 0x61,
+	0x6e, 0x58, 0x70, 0x75, 0x62, 0x12, 0x11, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x58, 0x70, 0x75, 0x62,
+	0x53, 0x63, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x0d, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x58,
+	0x70, 0x75, 0x62, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x3e, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x73, 0x70, 0x76, 0x2e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x53, 0x63, 0x61, 0x6e, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x13, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x33, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x52,
+	0x61, 0x77, 0x54, 0x72, 0x78, 0x12, 0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x52, 0x61, 0x77, 0x54,
+	0x72, 0x78, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x14, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x52, 0x61, 0x77, 0x54, 0x72, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x0b,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x70,
+	0x76, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x10, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46,
+	0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x12, 0x2e,
+	0x73, 0x70, 0x76, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x37, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x62, 0x74, 0x12,
+	0x13, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x73, 0x62, 0x74,
+	0x41, 0x72, 0x67, 0x73, 0x1a, 0x14, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x6f,
+	0x63, 0x6b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x70, 0x76, 0x2e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x15, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x2f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x0e,
+	0x2e, 0x73, 0x70, 0x76, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x11,
+	0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x4c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x32, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x53, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x10, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x53, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x08, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44,
+	0x42, 0x12, 0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x13, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x0f, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x11, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x11, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x72, 0x78, 0x73, 0x12,
+	0x10, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x0e, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x72, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x41, 0x72, 0x67, 0x73,
+	0x1a, 0x0a, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x30, 0x01, 0x12, 0x3f,
+	0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x78, 0x73, 0x12,
+	0x16, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x72, 0x78, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x14, 0x2e, 0x73, 0x70, 0x76, 0x2e, 0x54, 0x72,
+	0x78, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x42,
+	0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x75,
+	0x74, 0x61, 0x6c, 0x69, 0x73, 0x6b, 0x39, 0x39, 0x39, 0x2f, 0x62, 0x69, 0x74, 0x63, 0x6f, 0x69,
+	0x6e, 0x2d, 0x73, 0x70, 0x76, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_spv_proto_rawDescOnce sync.Once
+	file_spv_proto_rawDescData = file_spv_proto_rawDesc
+)
+
+func file_spv_proto_rawDescGZIP() []byte {
+	file_spv_proto_rawDescOnce.Do(func() {
+		file_spv_proto_rawDescData = protoimpl.X.CompressGZIP(file_spv_proto_rawDescData)
+	})
+	return file_spv_proto_rawDescData
+}
+
+var file_spv_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_spv_proto_goTypes = []interface{}{
+	(*EmptyArgs)(nil),           // 0: spv.EmptyArgs
+	(*CountReply)(nil),          // 1: spv.CountReply
+	(*TrxSeqArgs)(nil),          // 2: spv.TrxSeqArgs
+	(*TrxIdArgs)(nil),           // 3: spv.TrxIdArgs
+	(*TrxIdReply)(nil),          // 4: spv.TrxIdReply
+	(*AddressArgs)(nil),         // 5: spv.AddressArgs
+	(*AddressesArgs)(nil),       // 6: spv.AddressesArgs
+	(*BlockHeightArgs)(nil),     // 7: spv.BlockHeightArgs
+	(*TrxStatus)(nil),           // 8: spv.TrxStatus
+	(*TrxStatusList)(nil),       // 9: spv.TrxStatusList
+	(*BlockQueryArgs)(nil),      // 10: spv.BlockQueryArgs
+	(*Block)(nil),               // 11: spv.Block
+	(*RawTrxReply)(nil),         // 12: spv.RawTrxReply
+	(*OutPoint)(nil),            // 13: spv.OutPoint
+	(*TxIn)(nil),                // 14: spv.TxIn
+	(*TxOut)(nil),               // 15: spv.TxOut
+	(*Trx)(nil),                 // 16: spv.Trx
+	(*TxInVerbose)(nil),         // 17: spv.TxInVerbose
+	(*TrxVerbose)(nil),          // 18: spv.TrxVerbose
+	(*UtxoSource)(nil),          // 19: spv.UtxoSource
+	(*UtxoSourceList)(nil),      // 20: spv.UtxoSourceList
+	(*UtxoDetail)(nil),          // 21: spv.UtxoDetail
+	(*UtxoDetailList)(nil),      // 22: spv.UtxoDetailList
+	(*Utxo)(nil),                // 23: spv.Utxo
+	(*AddressTrxIds)(nil),       // 24: spv.AddressTrxIds
+	(*AddressesTrxs)(nil),       // 25: spv.AddressesTrxs
+	(*AddressUtxos)(nil),        // 26: spv.AddressUtxos
+	(*AddressUtxosList)(nil),    // 27: spv.AddressUtxosList
+	(*AddressBalance)(nil),      // 28: spv.AddressBalance
+	(*AddressesBalance)(nil),    // 29: spv.AddressesBalance
+	(*XpubScanArgs)(nil),        // 30: spv.XpubScanArgs
+	(*XpubAddress)(nil),         // 31: spv.XpubAddress
+	(*XpubScan)(nil),            // 32: spv.XpubScan
+	(*DescriptorScanArgs)(nil),  // 33: spv.DescriptorScanArgs
+	(*DescriptorAddress)(nil),   // 34: spv.DescriptorAddress
+	(*DescriptorScan)(nil),      // 35: spv.DescriptorScan
+	(*RawTrxArgs)(nil),          // 36: spv.RawTrxArgs
+	(*SendRawTrxReply)(nil),     // 37: spv.SendRawTrxReply
+	(*EstimateFeeArgs)(nil),     // 38: spv.EstimateFeeArgs
+	(*FeeEstimate)(nil),         // 39: spv.FeeEstimate
+	(*BlockFeeStats)(nil),       // 40: spv.BlockFeeStats
+	(*PsbtOutputArgs)(nil),      // 41: spv.PsbtOutputArgs
+	(*CreatePsbtArgs)(nil),      // 42: spv.CreatePsbtArgs
+	(*CreatePsbtReply)(nil),     // 43: spv.CreatePsbtReply
+	(*LockUnspentArgs)(nil),     // 44: spv.LockUnspentArgs
+	(*LockUnspentReply)(nil),    // 45: spv.LockUnspentReply
+	(*UnlockUnspentArgs)(nil),   // 46: spv.UnlockUnspentArgs
+	(*UtxoLock)(nil),            // 47: spv.UtxoLock
+	(*UtxoLockList)(nil),        // 48: spv.UtxoLockList
+	(*UtxoScriptTypeInfo)(nil),  // 49: spv.UtxoScriptTypeInfo
+	(*UtxoSetInfo)(nil),         // 50: spv.UtxoSetInfo
+	(*BackupArgs)(nil),          // 51: spv.BackupArgs
+	(*BackupManifest)(nil),      // 52: spv.BackupManifest
+	(*VerifyArgs)(nil),          // 53: spv.VerifyArgs
+	(*VerifyReport)(nil),        // 54: spv.VerifyReport
+	(*SubscribeBlocksArgs)(nil), // 55: spv.SubscribeBlocksArgs
+	(*SubscribeTrxsArgs)(nil),   // 56: spv.SubscribeTrxsArgs
+	(*TrxNotification)(nil),     // 57: spv.TrxNotification
+}
+var file_spv_proto_depIdxs = []int32{
+	8,  // 0: spv.TrxStatusList.Trxs:type_name -> spv.TrxStatus
+	13, // 1: spv.TxIn.PrevOut:type_name -> spv.OutPoint
+	8,  // 2: spv.Trx.Status:type_name -> spv.TrxStatus
+	14, // 3: spv.Trx.Vin:type_name -> spv.TxIn
+	15, // 4: spv.Trx.Vout:type_name -> spv.TxOut
+	13, // 5: spv.TxInVerbose.PrevOut:type_name -> spv.OutPoint
+	8,  // 6: spv.TrxVerbose.Status:type_name -> spv.TrxStatus
+	17, // 7: spv.TrxVerbose.Vin:type_name -> spv.TxInVerbose
+	15, // 8: spv.TrxVerbose.Vout:type_name -> spv.TxOut
+	19, // 9: spv.UtxoSourceList.Utxos:type_name -> spv.UtxoSource
+	21, // 10: spv.UtxoDetailList.Utxos:type_name -> spv.UtxoDetail
+	24, // 11: spv.AddressesTrxs.Addresses:type_name -> spv.AddressTrxIds
+	8,  // 12: spv.AddressesTrxs.Trxs:type_name -> spv.TrxStatus
+	23, // 13: spv.AddressUtxos.Utxos:type_name -> spv.Utxo
+	26, // 14: spv.AddressUtxosList.Addresses:type_name -> spv.AddressUtxos
+	28, // 15: spv.AddressesBalance.Addresses:type_name -> spv.AddressBalance
+	31, // 16: spv.XpubScan.Addresses:type_name -> spv.XpubAddress
+	8,  // 17: spv.XpubScan.Trxs:type_name -> spv.TrxStatus
+	23, // 18: spv.XpubScan.Utxos:type_name -> spv.Utxo
+	34, // 19: spv.DescriptorScan.Addresses:type_name -> spv.DescriptorAddress
+	8,  // 20: spv.DescriptorScan.Trxs:type_name -> spv.TrxStatus
+	23, // 21: spv.DescriptorScan.Utxos:type_name -> spv.Utxo
+	19, // 22: spv.SendRawTrxReply.MissingInputs:type_name -> spv.UtxoSource
+	41, // 23: spv.CreatePsbtArgs.Outputs:type_name -> spv.PsbtOutputArgs
+	23, // 24: spv.CreatePsbtReply.Inputs:type_name -> spv.Utxo
+	19, // 25: spv.LockUnspentArgs.Utxos:type_name -> spv.UtxoSource
+	47, // 26: spv.LockUnspentReply.Locks:type_name -> spv.UtxoLock
+	19, // 27: spv.UnlockUnspentArgs.Utxos:type_name -> spv.UtxoSource
+	47, // 28: spv.UtxoLockList.Locks:type_name -> spv.UtxoLock
+	49, // 29: spv.UtxoSetInfo.ScriptTypes:type_name -> spv.UtxoScriptTypeInfo
+	0,  // 30: spv.SpvService.GetBlockCount:input_type -> spv.EmptyArgs
+	0,  // 31: spv.SpvService.GetTrxCount:input_type -> spv.EmptyArgs
+	2,  // 32: spv.SpvService.GetTrxIdBySeq:input_type -> spv.TrxSeqArgs
+	5,  // 33: spv.SpvService.GetAddressTrxs:input_type -> spv.AddressArgs
+	3,  // 34: spv.SpvService.GetTrxStatus:input_type -> spv.TrxIdArgs
+	10, // 35: spv.SpvService.GetBlock:input_type -> spv.BlockQueryArgs
+	3,  // 36: spv.SpvService.GetRawTrx:input_type -> spv.TrxIdArgs
+	3,  // 37: spv.SpvService.GetTrx:input_type -> spv.TrxIdArgs
+	3,  // 38: spv.SpvService.GetTrxVerbose:input_type -> spv.TrxIdArgs
+	19, // 39: spv.SpvService.GetUtxo:input_type -> spv.UtxoSource
+	5,  // 40: spv.SpvService.ListUnSpent:input_type -> spv.AddressArgs
+	6,  // 41: spv.SpvService.GetAddressesTrxs:input_type -> spv.AddressesArgs
+	6,  // 42: spv.SpvService.ListUnSpentMulti:input_type -> spv.AddressesArgs
+	6,  // 43: spv.SpvService.GetAddressesBalance:input_type -> spv.AddressesArgs
+	30, // 44: spv.SpvService.ScanXpub:input_type -> spv.XpubScanArgs
+	33, // 45: spv.SpvService.ScanDescriptor:input_type -> spv.DescriptorScanArgs
+	36, // 46: spv.SpvService.SendRawTrx:input_type -> spv.RawTrxArgs
+	38, // 47: spv.SpvService.EstimateFee:input_type -> spv.EstimateFeeArgs
+	7,  // 48: spv.SpvService.GetBlockFeeStats:input_type -> spv.BlockHeightArgs
+	42, // 49: spv.SpvService.CreatePsbt:input_type -> spv.CreatePsbtArgs
+	44, // 50: spv.SpvService.LockUnspent:input_type -> spv.LockUnspentArgs
+	46, // 51: spv.SpvService.UnlockUnspent:input_type -> spv.UnlockUnspentArgs
+	0,  // 52: spv.SpvService.ListLocked:input_type -> spv.EmptyArgs
+	0,  // 53: spv.SpvService.GetUtxoSetInfo:input_type -> spv.EmptyArgs
+	51, // 54: spv.SpvService.BackupDB:input_type -> spv.BackupArgs
+	53, // 55: spv.SpvService.VerifyIndex:input_type -> spv.VerifyArgs
+	5,  // 56: spv.SpvService.StreamAddressTrxs:input_type -> spv.AddressArgs
+	55, // 57: spv.SpvService.SubscribeBlocks:input_type -> spv.SubscribeBlocksArgs
+	56, // 58: spv.SpvService.SubscribeTrxs:input_type -> spv.SubscribeTrxsArgs
+	1,  // 59: spv.SpvService.GetBlockCount:output_type -> spv.CountReply
+	1,  // 60: spv.SpvService.GetTrxCount:output_type -> spv.CountReply
+	4,  // 61: spv.SpvService.GetTrxIdBySeq:output_type -> spv.TrxIdReply
+	9,  // 62: spv.SpvService.GetAddressTrxs:output_type -> spv.TrxStatusList
+	8,  // 63: spv.SpvService.GetTrxStatus:output_type -> spv.TrxStatus
+	11, // 64: spv.SpvService.GetBlock:output_type -> spv.Block
+	12, // 65: spv.SpvService.GetRawTrx:output_type -> spv.RawTrxReply
+	16, // 66: spv.SpvService.GetTrx:output_type -> spv.Trx
+	18, // 67: spv.SpvService.GetTrxVerbose:output_type -> spv.TrxVerbose
+	21, // 68: spv.SpvService.GetUtxo:output_type -> spv.UtxoDetail
+	22, // 69: spv.SpvService.ListUnSpent:output_type -> spv.UtxoDetailList
+	25, // 70: spv.SpvService.GetAddressesTrxs:output_type -> spv.AddressesTrxs
+	27, // 71: spv.SpvService.ListUnSpentMulti:output_type -> spv.AddressUtxosList
+	29, // 72: spv.SpvService.GetAddressesBalance:output_type -> spv.AddressesBalance
+	32, // 73: spv.SpvService.ScanXpub:output_type -> spv.XpubScan
+	35, // 74: spv.SpvService.ScanDescriptor:output_type -> spv.DescriptorScan
+	37, // 75: spv.SpvService.SendRawTrx:output_type -> spv.SendRawTrxReply
+	39, // 76: spv.SpvService.EstimateFee:output_type -> spv.FeeEstimate
+	40, // 77: spv.SpvService.GetBlockFeeStats:output_type -> spv.BlockFeeStats
+	43, // 78: spv.SpvService.CreatePsbt:output_type -> spv.CreatePsbtReply
+	45, // 79: spv.SpvService.LockUnspent:output_type -> spv.LockUnspentReply
+	1,  // 80: spv.SpvService.UnlockUnspent:output_type -> spv.CountReply
+	48, // 81: spv.SpvService.ListLocked:output_type -> spv.UtxoLockList
+	50, // 82: spv.SpvService.GetUtxoSetInfo:output_type -> spv.UtxoSetInfo
+	52, // 83: spv.SpvService.BackupDB:output_type -> spv.BackupManifest
+	54, // 84: spv.SpvService.VerifyIndex:output_type -> spv.VerifyReport
+	8,  // 85: spv.SpvService.StreamAddressTrxs:output_type -> spv.TrxStatus
+	11, // 86: spv.SpvService.SubscribeBlocks:output_type -> spv.Block
+	57, // 87: spv.SpvService.SubscribeTrxs:output_type -> spv.TrxNotification
+	59, // [59:88] is the sub-list for method output_type
+	30, // [30:59] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_spv_proto_init() }
+func file_spv_proto_init() {
+	if File_spv_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_spv_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrxSeqArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrxIdArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrxIdReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressesArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeightArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrxStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrxStatusList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockQueryArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Block); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawTrxReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxInVerbose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrxVerbose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoSourceList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoDetailList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Utxo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressTrxIds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressesTrxs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressUtxos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressUtxosList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressesBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XpubScanArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XpubAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*XpubScan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptorScanArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptorAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptorScan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawTrxArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendRawTrxReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeeArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockFeeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PsbtOutputArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePsbtArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePsbtReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockUnspentArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockUnspentReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockUnspentArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoLockList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoScriptTypeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoSetInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeBlocksArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeTrxsArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spv_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrxNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_spv_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   58,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_spv_proto_goTypes,
+		DependencyIndexes: file_spv_proto_depIdxs,
+		MessageInfos:      file_spv_proto_msgTypes,
+	}.Build()
+	File_spv_proto = out.File
+	file_spv_proto_rawDesc = nil
+	file_spv_proto_goTypes = nil
+	file_spv_proto_depIdxs = nil
+}
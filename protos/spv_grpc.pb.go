@@ -0,0 +1,1193 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package protos
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// SpvServiceClient is the client API for SpvService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SpvServiceClient interface {
+	GetBlockCount(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*CountReply, error)
+	GetTrxCount(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*CountReply, error)
+	GetTrxIdBySeq(ctx context.Context, in *TrxSeqArgs, opts ...grpc.CallOption) (*TrxIdReply, error)
+	GetAddressTrxs(ctx context.Context, in *AddressArgs, opts ...grpc.CallOption) (*TrxStatusList, error)
+	GetTrxStatus(ctx context.Context, in *TrxIdArgs, opts ...grpc.CallOption) (*TrxStatus, error)
+	GetBlock(ctx context.Context, in *BlockQueryArgs, opts ...grpc.CallOption) (*Block, error)
+	GetRawTrx(ctx context.Context, in *TrxIdArgs, opts ...grpc.CallOption) (*RawTrxReply, error)
+	GetTrx(ctx context.Context, in *TrxIdArgs, opts ...grpc.CallOption) (*Trx, error)
+	GetTrxVerbose(ctx context.Context, in *TrxIdArgs, opts ...grpc.CallOption) (*TrxVerbose, error)
+	GetUtxo(ctx context.Context, in *UtxoSource, opts ...grpc.CallOption) (*UtxoDetail, error)
+	ListUnSpent(ctx context.Context, in *AddressArgs, opts ...grpc.CallOption) (*UtxoDetailList, error)
+	GetAddressesTrxs(ctx context.Context, in *AddressesArgs, opts ...grpc.CallOption) (*AddressesTrxs, error)
+	ListUnSpentMulti(ctx context.Context, in *AddressesArgs, opts ...grpc.CallOption) (*AddressUtxosList, error)
+	GetAddressesBalance(ctx context.Context, in *AddressesArgs, opts ...grpc.CallOption) (*AddressesBalance, error)
+	ScanXpub(ctx context.Context, in *XpubScanArgs, opts ...grpc.CallOption) (*XpubScan, error)
+	ScanDescriptor(ctx context.Context, in *DescriptorScanArgs, opts ...grpc.CallOption) (*DescriptorScan, error)
+	SendRawTrx(ctx context.Context, in *RawTrxArgs, opts ...grpc.CallOption) (*SendRawTrxReply, error)
+	EstimateFee(ctx context.Context, in *EstimateFeeArgs, opts ...grpc.CallOption) (*FeeEstimate, error)
+	GetBlockFeeStats(ctx context.Context, in *BlockHeightArgs, opts ...grpc.CallOption) (*BlockFeeStats, error)
+	CreatePsbt(ctx context.Context, in *CreatePsbtArgs, opts ...grpc.CallOption) (*CreatePsbtReply, error)
+	LockUnspent(ctx context.Context, in *LockUnspentArgs, opts ...grpc.CallOption) (*LockUnspentReply, error)
+	UnlockUnspent(ctx context.Context, in *UnlockUnspentArgs, opts ...grpc.CallOption) (*CountReply, error)
+	ListLocked(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*UtxoLockList, error)
+	GetUtxoSetInfo(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*UtxoSetInfo, error)
+	BackupDB(ctx context.Context, in *BackupArgs, opts ...grpc.CallOption) (*BackupManifest, error)
+	VerifyIndex(ctx context.Context, in *VerifyArgs, opts ...grpc.CallOption) (*VerifyReport, error)
+	// the trxs of an address, oldest first, without materializing the whole history
+	StreamAddressTrxs(ctx context.Context, in *AddressArgs, opts ...grpc.CallOption) (SpvService_StreamAddressTrxsClient, error)
+	// the blocks indexed from FromHeight (the next block if 0), then every newly indexed block
+	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksArgs, opts ...grpc.CallOption) (SpvService_SubscribeBlocksClient, error)
+	// the trxs of every newly indexed block, only the trxs of Addresses if not empty
+	SubscribeTrxs(ctx context.Context, in *SubscribeTrxsArgs, opts ...grpc.CallOption) (SpvService_SubscribeTrxsClient, error)
+}
+
+type spvServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSpvServiceClient(cc grpc.ClientConnInterface) SpvServiceClient {
+	return &spvServiceClient{cc}
+}
+
+func (c *spvServiceClient) GetBlockCount(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*CountReply, error) {
+	out := new(CountReply)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetBlockCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetTrxCount(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*CountReply, error) {
+	out := new(CountReply)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetTrxCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetTrxIdBySeq(ctx context.Context, in *TrxSeqArgs, opts ...grpc.CallOption) (*TrxIdReply, error) {
+	out := new(TrxIdReply)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetTrxIdBySeq", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetAddressTrxs(ctx context.Context, in *AddressArgs, opts ...grpc.CallOption) (*TrxStatusList, error) {
+	out := new(TrxStatusList)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetAddressTrxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetTrxStatus(ctx context.Context, in *TrxIdArgs, opts ...grpc.CallOption) (*TrxStatus, error) {
+	out := new(TrxStatus)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetTrxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetBlock(ctx context.Context, in *BlockQueryArgs, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetRawTrx(ctx context.Context, in *TrxIdArgs, opts ...grpc.CallOption) (*RawTrxReply, error) {
+	out := new(RawTrxReply)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetRawTrx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetTrx(ctx context.Context, in *TrxIdArgs, opts ...grpc.CallOption) (*Trx, error) {
+	out := new(Trx)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetTrx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetTrxVerbose(ctx context.Context, in *TrxIdArgs, opts ...grpc.CallOption) (*TrxVerbose, error) {
+	out := new(TrxVerbose)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetTrxVerbose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetUtxo(ctx context.Context, in *UtxoSource, opts ...grpc.CallOption) (*UtxoDetail, error) {
+	out := new(UtxoDetail)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetUtxo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) ListUnSpent(ctx context.Context, in *AddressArgs, opts ...grpc.CallOption) (*UtxoDetailList, error) {
+	out := new(UtxoDetailList)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/ListUnSpent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetAddressesTrxs(ctx context.Context, in *AddressesArgs, opts ...grpc.CallOption) (*AddressesTrxs, error) {
+	out := new(AddressesTrxs)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetAddressesTrxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) ListUnSpentMulti(ctx context.Context, in *AddressesArgs, opts ...grpc.CallOption) (*AddressUtxosList, error) {
+	out := new(AddressUtxosList)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/ListUnSpentMulti", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetAddressesBalance(ctx context.Context, in *AddressesArgs, opts ...grpc.CallOption) (*AddressesBalance, error) {
+	out := new(AddressesBalance)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetAddressesBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) ScanXpub(ctx context.Context, in *XpubScanArgs, opts ...grpc.CallOption) (*XpubScan, error) {
+	out := new(XpubScan)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/ScanXpub", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) ScanDescriptor(ctx context.Context, in *DescriptorScanArgs, opts ...grpc.CallOption) (*DescriptorScan, error) {
+	out := new(DescriptorScan)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/ScanDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) SendRawTrx(ctx context.Context, in *RawTrxArgs, opts ...grpc.CallOption) (*SendRawTrxReply, error) {
+	out := new(SendRawTrxReply)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/SendRawTrx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) EstimateFee(ctx context.Context, in *EstimateFeeArgs, opts ...grpc.CallOption) (*FeeEstimate, error) {
+	out := new(FeeEstimate)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetBlockFeeStats(ctx context.Context, in *BlockHeightArgs, opts ...grpc.CallOption) (*BlockFeeStats, error) {
+	out := new(BlockFeeStats)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetBlockFeeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) CreatePsbt(ctx context.Context, in *CreatePsbtArgs, opts ...grpc.CallOption) (*CreatePsbtReply, error) {
+	out := new(CreatePsbtReply)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/CreatePsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) LockUnspent(ctx context.Context, in *LockUnspentArgs, opts ...grpc.CallOption) (*LockUnspentReply, error) {
+	out := new(LockUnspentReply)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/LockUnspent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) UnlockUnspent(ctx context.Context, in *UnlockUnspentArgs, opts ...grpc.CallOption) (*CountReply, error) {
+	out := new(CountReply)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/UnlockUnspent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) ListLocked(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*UtxoLockList, error) {
+	out := new(UtxoLockList)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/ListLocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) GetUtxoSetInfo(ctx context.Context, in *EmptyArgs, opts ...grpc.CallOption) (*UtxoSetInfo, error) {
+	out := new(UtxoSetInfo)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/GetUtxoSetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) BackupDB(ctx context.Context, in *BackupArgs, opts ...grpc.CallOption) (*BackupManifest, error) {
+	out := new(BackupManifest)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/BackupDB", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) VerifyIndex(ctx context.Context, in *VerifyArgs, opts ...grpc.CallOption) (*VerifyReport, error) {
+	out := new(VerifyReport)
+	err := c.cc.Invoke(ctx, "/spv.SpvService/VerifyIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spvServiceClient) StreamAddressTrxs(ctx context.Context, in *AddressArgs, opts ...grpc.CallOption) (SpvService_StreamAddressTrxsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SpvService_serviceDesc.Streams[0], "/spv.SpvService/StreamAddressTrxs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spvServiceStreamAddressTrxsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SpvService_StreamAddressTrxsClient interface {
+	Recv() (*TrxStatus, error)
+	grpc.ClientStream
+}
+
+type spvServiceStreamAddressTrxsClient struct {
+	grpc.ClientStream
+}
+
+func (x *spvServiceStreamAddressTrxsClient) Recv() (*TrxStatus, error) {
+	m := new(TrxStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *spvServiceClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksArgs, opts ...grpc.CallOption) (SpvService_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SpvService_serviceDesc.Streams[1], "/spv.SpvService/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spvServiceSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SpvService_SubscribeBlocksClient interface {
+	Recv() (*Block, error)
+	grpc.ClientStream
+}
+
+type spvServiceSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *spvServiceSubscribeBlocksClient) Recv() (*Block, error) {
+	m := new(Block)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *spvServiceClient) SubscribeTrxs(ctx context.Context, in *SubscribeTrxsArgs, opts ...grpc.CallOption) (SpvService_SubscribeTrxsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SpvService_serviceDesc.Streams[2], "/spv.SpvService/SubscribeTrxs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spvServiceSubscribeTrxsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SpvService_SubscribeTrxsClient interface {
+	Recv() (*TrxNotification, error)
+	grpc.ClientStream
+}
+
+type spvServiceSubscribeTrxsClient struct {
+	grpc.ClientStream
+}
+
+func (x *spvServiceSubscribeTrxsClient) Recv() (*TrxNotification, error) {
+	m := new(TrxNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SpvServiceServer is the server API for SpvService service.
+// All implementations must embed UnimplementedSpvServiceServer
+// for forward compatibility
+type SpvServiceServer interface {
+	GetBlockCount(context.Context, *EmptyArgs) (*CountReply, error)
+	GetTrxCount(context.Context, *EmptyArgs) (*CountReply, error)
+	GetTrxIdBySeq(context.Context, *TrxSeqArgs) (*TrxIdReply, error)
+	GetAddressTrxs(context.Context, *AddressArgs) (*TrxStatusList, error)
+	GetTrxStatus(context.Context, *TrxIdArgs) (*TrxStatus, error)
+	GetBlock(context.Context, *BlockQueryArgs) (*Block, error)
+	GetRawTrx(context.Context, *TrxIdArgs) (*RawTrxReply, error)
+	GetTrx(context.Context, *TrxIdArgs) (*Trx, error)
+	GetTrxVerbose(context.Context, *TrxIdArgs) (*TrxVerbose, error)
+	GetUtxo(context.Context, *UtxoSource) (*UtxoDetail, error)
+	ListUnSpent(context.Context, *AddressArgs) (*UtxoDetailList, error)
+	GetAddressesTrxs(context.Context, *AddressesArgs) (*AddressesTrxs, error)
+	ListUnSpentMulti(context.Context, *AddressesArgs) (*AddressUtxosList, error)
+	GetAddressesBalance(context.Context, *AddressesArgs) (*AddressesBalance, error)
+	ScanXpub(context.Context, *XpubScanArgs) (*XpubScan, error)
+	ScanDescriptor(context.Context, *DescriptorScanArgs) (*DescriptorScan, error)
+	SendRawTrx(context.Context, *RawTrxArgs) (*SendRawTrxReply, error)
+	EstimateFee(context.Context, *EstimateFeeArgs) (*FeeEstimate, error)
+	GetBlockFeeStats(context.Context, *BlockHeightArgs) (*BlockFeeStats, error)
+	CreatePsbt(context.Context, *CreatePsbtArgs) (*CreatePsbtReply, error)
+	LockUnspent(context.Context, *LockUnspentArgs) (*LockUnspentReply, error)
+	UnlockUnspent(context.Context, *UnlockUnspentArgs) (*CountReply, error)
+	ListLocked(context.Context, *EmptyArgs) (*UtxoLockList, error)
+	GetUtxoSetInfo(context.Context, *EmptyArgs) (*UtxoSetInfo, error)
+	BackupDB(context.Context, *BackupArgs) (*BackupManifest, error)
+	VerifyIndex(context.Context, *VerifyArgs) (*VerifyReport, error)
+	// the trxs of an address, oldest first, without materializing the whole history
+	StreamAddressTrxs(*AddressArgs, SpvService_StreamAddressTrxsServer) error
+	// the blocks indexed from FromHeight (the next block if 0), then every newly indexed block
+	SubscribeBlocks(*SubscribeBlocksArgs, SpvService_SubscribeBlocksServer) error
+	// the trxs of every newly indexed block, only the trxs of Addresses if not empty
+	SubscribeTrxs(*SubscribeTrxsArgs, SpvService_SubscribeTrxsServer) error
+	mustEmbedUnimplementedSpvServiceServer()
+}
+
+// UnimplementedSpvServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSpvServiceServer struct {
+}
+
+func (UnimplementedSpvServiceServer) GetBlockCount(context.Context, *EmptyArgs) (*CountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockCount not implemented")
+}
+func (UnimplementedSpvServiceServer) GetTrxCount(context.Context, *EmptyArgs) (*CountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrxCount not implemented")
+}
+func (UnimplementedSpvServiceServer) GetTrxIdBySeq(context.Context, *TrxSeqArgs) (*TrxIdReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrxIdBySeq not implemented")
+}
+func (UnimplementedSpvServiceServer) GetAddressTrxs(context.Context, *AddressArgs) (*TrxStatusList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressTrxs not implemented")
+}
+func (UnimplementedSpvServiceServer) GetTrxStatus(context.Context, *TrxIdArgs) (*TrxStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrxStatus not implemented")
+}
+func (UnimplementedSpvServiceServer) GetBlock(context.Context, *BlockQueryArgs) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (UnimplementedSpvServiceServer) GetRawTrx(context.Context, *TrxIdArgs) (*RawTrxReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawTrx not implemented")
+}
+func (UnimplementedSpvServiceServer) GetTrx(context.Context, *TrxIdArgs) (*Trx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrx not implemented")
+}
+func (UnimplementedSpvServiceServer) GetTrxVerbose(context.Context, *TrxIdArgs) (*TrxVerbose, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrxVerbose not implemented")
+}
+func (UnimplementedSpvServiceServer) GetUtxo(context.Context, *UtxoSource) (*UtxoDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUtxo not implemented")
+}
+func (UnimplementedSpvServiceServer) ListUnSpent(context.Context, *AddressArgs) (*UtxoDetailList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnSpent not implemented")
+}
+func (UnimplementedSpvServiceServer) GetAddressesTrxs(context.Context, *AddressesArgs) (*AddressesTrxs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressesTrxs not implemented")
+}
+func (UnimplementedSpvServiceServer) ListUnSpentMulti(context.Context, *AddressesArgs) (*AddressUtxosList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnSpentMulti not implemented")
+}
+func (UnimplementedSpvServiceServer) GetAddressesBalance(context.Context, *AddressesArgs) (*AddressesBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressesBalance not implemented")
+}
+func (UnimplementedSpvServiceServer) ScanXpub(context.Context, *XpubScanArgs) (*XpubScan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanXpub not implemented")
+}
+func (UnimplementedSpvServiceServer) ScanDescriptor(context.Context, *DescriptorScanArgs) (*DescriptorScan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanDescriptor not implemented")
+}
+func (UnimplementedSpvServiceServer) SendRawTrx(context.Context, *RawTrxArgs) (*SendRawTrxReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRawTrx not implemented")
+}
+func (UnimplementedSpvServiceServer) EstimateFee(context.Context, *EstimateFeeArgs) (*FeeEstimate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+func (UnimplementedSpvServiceServer) GetBlockFeeStats(context.Context, *BlockHeightArgs) (*BlockFeeStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockFeeStats not implemented")
+}
+func (UnimplementedSpvServiceServer) CreatePsbt(context.Context, *CreatePsbtArgs) (*CreatePsbtReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePsbt not implemented")
+}
+func (UnimplementedSpvServiceServer) LockUnspent(context.Context, *LockUnspentArgs) (*LockUnspentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockUnspent not implemented")
+}
+func (UnimplementedSpvServiceServer) UnlockUnspent(context.Context, *UnlockUnspentArgs) (*CountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUnspent not implemented")
+}
+func (UnimplementedSpvServiceServer) ListLocked(context.Context, *EmptyArgs) (*UtxoLockList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocked not implemented")
+}
+func (UnimplementedSpvServiceServer) GetUtxoSetInfo(context.Context, *EmptyArgs) (*UtxoSetInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUtxoSetInfo not implemented")
+}
+func (UnimplementedSpvServiceServer) BackupDB(context.Context, *BackupArgs) (*BackupManifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupDB not implemented")
+}
+func (UnimplementedSpvServiceServer) VerifyIndex(context.Context, *VerifyArgs) (*VerifyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyIndex not implemented")
+}
+func (UnimplementedSpvServiceServer) StreamAddressTrxs(*AddressArgs, SpvService_StreamAddressTrxsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAddressTrxs not implemented")
+}
+func (UnimplementedSpvServiceServer) SubscribeBlocks(*SubscribeBlocksArgs, SpvService_SubscribeBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
+}
+func (UnimplementedSpvServiceServer) SubscribeTrxs(*SubscribeTrxsArgs, SpvService_SubscribeTrxsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTrxs not implemented")
+}
+func (UnimplementedSpvServiceServer) mustEmbedUnimplementedSpvServiceServer() {}
+
+// UnsafeSpvServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SpvServiceServer will
+// result in compilation errors.
+type UnsafeSpvServiceServer interface {
+	mustEmbedUnimplementedSpvServiceServer()
+}
+
+func RegisterSpvServiceServer(s grpc.ServiceRegistrar, srv SpvServiceServer) {
+	s.RegisterService(&_SpvService_serviceDesc, srv)
+}
+
+func _SpvService_GetBlockCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetBlockCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetBlockCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetBlockCount(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetTrxCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetTrxCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetTrxCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetTrxCount(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetTrxIdBySeq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrxSeqArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetTrxIdBySeq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetTrxIdBySeq",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetTrxIdBySeq(ctx, req.(*TrxSeqArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetAddressTrxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetAddressTrxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetAddressTrxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetAddressTrxs(ctx, req.(*AddressArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetTrxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrxIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetTrxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetTrxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetTrxStatus(ctx, req.(*TrxIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockQueryArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetBlock(ctx, req.(*BlockQueryArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetRawTrx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrxIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetRawTrx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetRawTrx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetRawTrx(ctx, req.(*TrxIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetTrx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrxIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetTrx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetTrx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetTrx(ctx, req.(*TrxIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetTrxVerbose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrxIdArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetTrxVerbose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetTrxVerbose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetTrxVerbose(ctx, req.(*TrxIdArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetUtxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UtxoSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetUtxo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetUtxo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetUtxo(ctx, req.(*UtxoSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_ListUnSpent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).ListUnSpent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/ListUnSpent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).ListUnSpent(ctx, req.(*AddressArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetAddressesTrxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressesArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetAddressesTrxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetAddressesTrxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetAddressesTrxs(ctx, req.(*AddressesArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_ListUnSpentMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressesArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).ListUnSpentMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/ListUnSpentMulti",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).ListUnSpentMulti(ctx, req.(*AddressesArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetAddressesBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressesArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetAddressesBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetAddressesBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetAddressesBalance(ctx, req.(*AddressesArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_ScanXpub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XpubScanArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).ScanXpub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/ScanXpub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).ScanXpub(ctx, req.(*XpubScanArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_ScanDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescriptorScanArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).ScanDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/ScanDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).ScanDescriptor(ctx, req.(*DescriptorScanArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_SendRawTrx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RawTrxArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).SendRawTrx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/SendRawTrx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).SendRawTrx(ctx, req.(*RawTrxArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).EstimateFee(ctx, req.(*EstimateFeeArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetBlockFeeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeightArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetBlockFeeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetBlockFeeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetBlockFeeStats(ctx, req.(*BlockHeightArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_CreatePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePsbtArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).CreatePsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/CreatePsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).CreatePsbt(ctx, req.(*CreatePsbtArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_LockUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockUnspentArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).LockUnspent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/LockUnspent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).LockUnspent(ctx, req.(*LockUnspentArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_UnlockUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUnspentArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).UnlockUnspent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/UnlockUnspent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).UnlockUnspent(ctx, req.(*UnlockUnspentArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_ListLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).ListLocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/ListLocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).ListLocked(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_GetUtxoSetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).GetUtxoSetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/GetUtxoSetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).GetUtxoSetInfo(ctx, req.(*EmptyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_BackupDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).BackupDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/BackupDB",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).BackupDB(ctx, req.(*BackupArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_VerifyIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpvServiceServer).VerifyIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spv.SpvService/VerifyIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpvServiceServer).VerifyIndex(ctx, req.(*VerifyArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpvService_StreamAddressTrxs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AddressArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpvServiceServer).StreamAddressTrxs(m, &spvServiceStreamAddressTrxsServer{stream})
+}
+
+type SpvService_StreamAddressTrxsServer interface {
+	Send(*TrxStatus) error
+	grpc.ServerStream
+}
+
+type spvServiceStreamAddressTrxsServer struct {
+	grpc.ServerStream
+}
+
+func (x *spvServiceStreamAddressTrxsServer) Send(m *TrxStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SpvService_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpvServiceServer).SubscribeBlocks(m, &spvServiceSubscribeBlocksServer{stream})
+}
+
+type SpvService_SubscribeBlocksServer interface {
+	Send(*Block) error
+	grpc.ServerStream
+}
+
+type spvServiceSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *spvServiceSubscribeBlocksServer) Send(m *Block) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SpvService_SubscribeTrxs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTrxsArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpvServiceServer).SubscribeTrxs(m, &spvServiceSubscribeTrxsServer{stream})
+}
+
+type SpvService_SubscribeTrxsServer interface {
+	Send(*TrxNotification) error
+	grpc.ServerStream
+}
+
+type spvServiceSubscribeTrxsServer struct {
+	grpc.ServerStream
+}
+
+func (x *spvServiceSubscribeTrxsServer) Send(m *TrxNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _SpvService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "spv.SpvService",
+	HandlerType: (*SpvServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBlockCount",
+			Handler:    _SpvService_GetBlockCount_Handler,
+		},
+		{
+			MethodName: "GetTrxCount",
+			Handler:    _SpvService_GetTrxCount_Handler,
+		},
+		{
+			MethodName: "GetTrxIdBySeq",
+			Handler:    _SpvService_GetTrxIdBySeq_Handler,
+		},
+		{
+			MethodName: "GetAddressTrxs",
+			Handler:    _SpvService_GetAddressTrxs_Handler,
+		},
+		{
+			MethodName: "GetTrxStatus",
+			Handler:    _SpvService_GetTrxStatus_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _SpvService_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetRawTrx",
+			Handler:    _SpvService_GetRawTrx_Handler,
+		},
+		{
+			MethodName: "GetTrx",
+			Handler:    _SpvService_GetTrx_Handler,
+		},
+		{
+			MethodName: "GetTrxVerbose",
+			Handler:    _SpvService_GetTrxVerbose_Handler,
+		},
+		{
+			MethodName: "GetUtxo",
+			Handler:    _SpvService_GetUtxo_Handler,
+		},
+		{
+			MethodName: "ListUnSpent",
+			Handler:    _SpvService_ListUnSpent_Handler,
+		},
+		{
+			MethodName: "GetAddressesTrxs",
+			Handler:    _SpvService_GetAddressesTrxs_Handler,
+		},
+		{
+			MethodName: "ListUnSpentMulti",
+			Handler:    _SpvService_ListUnSpentMulti_Handler,
+		},
+		{
+			MethodName: "GetAddressesBalance",
+			Handler:    _SpvService_GetAddressesBalance_Handler,
+		},
+		{
+			MethodName: "ScanXpub",
+			Handler:    _SpvService_ScanXpub_Handler,
+		},
+		{
+			MethodName: "ScanDescriptor",
+			Handler:    _SpvService_ScanDescriptor_Handler,
+		},
+		{
+			MethodName: "SendRawTrx",
+			Handler:    _SpvService_SendRawTrx_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _SpvService_EstimateFee_Handler,
+		},
+		{
+			MethodName: "GetBlockFeeStats",
+			Handler:    _SpvService_GetBlockFeeStats_Handler,
+		},
+		{
+			MethodName: "CreatePsbt",
+			Handler:    _SpvService_CreatePsbt_Handler,
+		},
+		{
+			MethodName: "LockUnspent",
+			Handler:    _SpvService_LockUnspent_Handler,
+		},
+		{
+			MethodName: "UnlockUnspent",
+			Handler:    _SpvService_UnlockUnspent_Handler,
+		},
+		{
+			MethodName: "ListLocked",
+			Handler:    _SpvService_ListLocked_Handler,
+		},
+		{
+			MethodName: "GetUtxoSetInfo",
+			Handler:    _SpvService_GetUtxoSetInfo_Handler,
+		},
+		{
+			MethodName: "BackupDB",
+			Handler:    _SpvService_BackupDB_Handler,
+		},
+		{
+			MethodName: "VerifyIndex",
+			Handler:    _SpvService_VerifyIndex_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAddressTrxs",
+			Handler:       _SpvService_StreamAddressTrxs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _SpvService_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTrxs",
+			Handler:       _SpvService_SubscribeTrxs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "spv.proto",
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/mutalisk999/bitcoin-lib/src/bigint"
+	"github.com/mutalisk999/go-lib/src/sched/goroutine_mgr"
+	"strconv"
+	"time"
+)
+
+var errTrxUnspentFound = errors.New("trx unspent found")
+
+func isRawTrxPruneEnabled() bool {
+	return config.GatherConfig.StoreRawTrx && config.GatherConfig.PruneRawTrxBlocks != 0
+}
+
+func getRawTrxPruneHeight() (uint32, error) {
+	pruneHeightStr, err := globalConfigDBMgr.DBGet("rawTrxPruneHeight")
+	if err != nil {
+		if err.Error() == NotFoundError {
+			return 0, nil
+		}
+		return 0, err
+	}
+	ui64, err := strconv.ParseUint(pruneHeightStr, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(ui64), nil
+}
+
+func storeRawTrxPruneHeight(pruneHeight uint32) error {
+	err := globalConfigDBMgr.DBPut("rawTrxPruneHeight", strconv.Itoa(int(pruneHeight)))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// isTrxUnspent ignores the provably unspendable outputs, they are never spent
+func isTrxUnspent(trxId bigint.Uint256) (bool, error) {
+	err := utxoDBMgr.DBIterateTrxId(trxId, func(utxoSrc UtxoSource, utxoDetail UtxoDetail) error {
+		if isUnspendableScript(utxoDetail.ScriptPubKey) {
+			return nil
+		}
+		return errTrxUnspentFound
+	})
+	if err == errTrxUnspentFound {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func pruneRawTrx(trxId bigint.Uint256) error {
+	isUnspent, err := isTrxUnspent(trxId)
+	if err != nil {
+		return err
+	}
+	if isUnspent {
+		return nil
+	}
+	return rawTrxDBMgr.DBDelete(trxId)
+}
+
+// pruneSpentRawTrxs is called while flushing the slot cache, the trxs under the prune height
+// are kept only for their unspent outputs, prune them once all the outputs are spent
+func pruneSpentRawTrxs(slotCache *SlotCache) error {
+	if !isRawTrxPruneEnabled() {
+		return nil
+	}
+	pruneHeight, err := getRawTrxPruneHeight()
+	if err != nil {
+		return err
+	}
+	trxIdsPrune := make(map[string]bigint.Uint256)
+	for utxoSrcStr, utxoDetail := range slotCache.UtxosDel {
+		if utxoDetail.BlockHeight > pruneHeight {
+			continue
+		}
+		var utxoSrc UtxoSource
+		err := utxoSrc.FromStreamString(utxoSrcStr)
+		if err != nil {
+			return err
+		}
+		trxIdsPrune[string(utxoSrc.TrxId.GetData())] = utxoSrc.TrxId
+	}
+	for _, trxId := range trxIdsPrune {
+		err = pruneRawTrx(trxId)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pruneRawTrxsOfBlock(blockHeight uint32) error {
+	blockInfo, err := blockDBMgr.DBGet(blockHeight)
+	if err != nil {
+		return errors.New("block info not found, height: " + strconv.Itoa(int(blockHeight)))
+	}
+	for trxSeq := blockInfo.FirstTrxSeq; trxSeq < blockInfo.FirstTrxSeq+blockInfo.TrxCount; trxSeq++ {
+		trxId, err := trxSeqDBMgr.DBGet(trxSeq)
+		if err != nil {
+			return err
+		}
+		err = pruneRawTrx(trxId)
+		if err != nil {
+			return err
+		}
+	}
+	return storeRawTrxPruneHeight(blockHeight)
+}
+
+func pruneRawTrxs() (uint32, error) {
+	var pruneCount uint32
+	for {
+		if quitFlag {
+			break
+		}
+		// prune block by block, so that the flush is not blocked for a long time
+		flushMutex.Lock()
+		if quitFlag {
+			flushMutex.Unlock()
+			break
+		}
+		blockHeight, err := getStartBlockHeight()
+		if err != nil {
+			flushMutex.Unlock()
+			return pruneCount, err
+		}
+		pruneHeight, err := getRawTrxPruneHeight()
+		if err != nil {
+			flushMutex.Unlock()
+			return pruneCount, err
+		}
+		if blockHeight <= config.GatherConfig.PruneRawTrxBlocks || pruneHeight >= blockHeight-config.GatherConfig.PruneRawTrxBlocks {
+			flushMutex.Unlock()
+			break
+		}
+		err = pruneRawTrxsOfBlock(pruneHeight + 1)
+		flushMutex.Unlock()
+		if err != nil {
+			return pruneCount, err
+		}
+		pruneCount += 1
+	}
+	return pruneCount, nil
+}
+
+func doPruneRawTrx(goroutine goroutine_mgr.Goroutine, args ...interface{}) {
+	defer goroutine.OnQuit()
+	for {
+		if quitFlag {
+			break
+		}
+		_, err := pruneRawTrxs()
+		if err != nil {
+			fmt.Println("prune raw trx", err)
+			break
+		}
+		time.Sleep(5 * 1000 * 1000 * 1000)
+	}
+}
+
+func startPruneRawTrx() uint64 {
+	return goroutineMgr.GoroutineCreatePn("prunerawtrx", doPruneRawTrx, nil)
+}
@@ -0,0 +1,323 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/bigint"
+	"github.com/mutalisk999/bitcoin-lib/src/serialize"
+	"github.com/mutalisk999/bitcoin-lib/src/transaction"
+	"io"
+	"strconv"
+)
+
+const (
+	PsbtMagic            = "psbt\xff"
+	PsbtGlobalUnsignedTx = 0x00
+	PsbtInNonWitnessUtxo = 0x00
+	PsbtInWitnessUtxo    = 0x01
+	PsbtSeparator        = 0x00
+
+	PsbtTrxVersion       = 2
+	PsbtSequenceRbf      = 0xfffffffd
+	PsbtFeeTargetDefault = 6
+	PsbtOutputsMax       = 1000
+)
+
+type PsbtOutputArgs struct {
+	Address string
+	Amount  int64
+}
+
+type CreatePsbtArgs struct {
+	FromAddresses []string
+	Outputs       []PsbtOutputArgs
+	FeeRate       float64
+	ChangeAddress string
+	IncludeLocked bool
+	// the redeem script of the p2sh utxos is unknown, they are spent only if the type is "p2sh-p2wpkh"
+	P2shScriptType string
+}
+
+type CreatePsbtPrintAble struct {
+	Psbt           string
+	Algorithm      string
+	FeeRate        float64
+	Fee            int64
+	VSize          int
+	ChangePosition int
+	ChangeAmount   int64
+	Inputs         []UtxoPrintAble
+}
+
+func writePsbtKeyValue(writer io.Writer, keyType byte, keyData []byte, value []byte) error {
+	err := serialize.PackCompactSize(writer, uint64(len(keyData)+1))
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(append([]byte{keyType}, keyData...))
+	if err != nil {
+		return err
+	}
+	err = serialize.PackCompactSize(writer, uint64(len(value)))
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(value)
+	return err
+}
+
+// getNonWitnessUtxo serializes the previous trx without witness as BIP174 requires
+func getNonWitnessUtxo(trxIdStr string) ([]byte, error) {
+	var trxId bigint.Uint256
+	err := trxId.SetHex(trxIdStr)
+	if err != nil {
+		return nil, err
+	}
+	rawTrxBytes, err := getRawTrxBytes(trxId)
+	if err != nil {
+		return nil, err
+	}
+	var trx transaction.Transaction
+	err = trx.UnPack(bytes.NewReader(rawTrxBytes))
+	if err != nil {
+		return nil, err
+	}
+	bytesBuf := bytes.NewBuffer([]byte{})
+	err = trx.PackNoWitness(io.Writer(bytesBuf))
+	if err != nil {
+		return nil, err
+	}
+	return bytesBuf.Bytes(), nil
+}
+
+// encodePsbt fills witness_utxo for segwit inputs and non_witness_utxo for legacy and segwit v0 inputs,
+// the full previous trx lets signers verify the input amount of segwit v0 inputs too
+func encodePsbt(trx *transaction.Transaction, inputs []coinCandidate) (string, error) {
+	bytesBuf := bytes.NewBuffer([]byte(PsbtMagic))
+	writer := io.Writer(bytesBuf)
+
+	trxBuf := bytes.NewBuffer([]byte{})
+	err := trx.PackNoWitness(io.Writer(trxBuf))
+	if err != nil {
+		return "", err
+	}
+	err = writePsbtKeyValue(writer, PsbtGlobalUnsignedTx, nil, trxBuf.Bytes())
+	if err != nil {
+		return "", err
+	}
+	err = serialize.PackByte(writer, PsbtSeparator)
+	if err != nil {
+		return "", err
+	}
+
+	for _, input := range inputs {
+		isTaproot := input.scriptPubKey[0] == 0x51
+		if !isTaproot {
+			nonWitnessUtxo, err := getNonWitnessUtxo(input.utxo.TrxId)
+			if err != nil && !input.isWitness {
+				return "", newNotFoundError("raw transaction of input " + input.utxo.TrxId)
+			}
+			if err == nil {
+				err = writePsbtKeyValue(writer, PsbtInNonWitnessUtxo, nil, nonWitnessUtxo)
+				if err != nil {
+					return "", err
+				}
+			}
+		}
+		if input.isWitness {
+			var txOut transaction.TxOut
+			txOut.Value = input.utxo.Amount
+			txOut.ScriptPubKey.SetScriptBytes(input.scriptPubKey)
+			txOutBuf := bytes.NewBuffer([]byte{})
+			err = txOut.Pack(io.Writer(txOutBuf))
+			if err != nil {
+				return "", err
+			}
+			err = writePsbtKeyValue(writer, PsbtInWitnessUtxo, nil, txOutBuf.Bytes())
+			if err != nil {
+				return "", err
+			}
+		}
+		err = serialize.PackByte(writer, PsbtSeparator)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	for i := 0; i < len(trx.Vout); i++ {
+		err = serialize.PackByte(writer, PsbtSeparator)
+		if err != nil {
+			return "", err
+		}
+	}
+	return base64.StdEncoding.EncodeToString(bytesBuf.Bytes()), nil
+}
+
+func getPsbtFeeRate(feeRate float64) (float64, error) {
+	if feeRate == 0 {
+		estimate, err := estimateFee(PsbtFeeTargetDefault)
+		if err != nil {
+			return config.BroadcastConfig.MinFeeRate, nil
+		}
+		return estimate.FeeRate, nil
+	}
+	if feeRate < config.BroadcastConfig.MinFeeRate {
+		return 0, errors.New("fee rate is lower than " + strconv.FormatFloat(config.BroadcastConfig.MinFeeRate, 'f', 3, 64) + " sat/vB")
+	}
+	return feeRate, nil
+}
+
+func createPsbt(args *CreatePsbtArgs) (CreatePsbtPrintAble, error) {
+	addresses, err := uniqueAddresses(args.FromAddresses)
+	if err != nil {
+		return CreatePsbtPrintAble{}, err
+	}
+	if len(addresses) == 0 {
+		return CreatePsbtPrintAble{}, errors.New("from addresses is empty")
+	}
+	if len(args.Outputs) == 0 {
+		return CreatePsbtPrintAble{}, errors.New("outputs is empty")
+	}
+	if len(args.Outputs) > PsbtOutputsMax {
+		return CreatePsbtPrintAble{}, errors.New("too many outputs, max: " + strconv.Itoa(PsbtOutputsMax))
+	}
+
+	if args.P2shScriptType != "" && args.P2shScriptType != XpubScriptTypeP2SHWPK {
+		return CreatePsbtPrintAble{}, errors.New("not support p2sh script type: " + args.P2shScriptType)
+	}
+
+	var psbt CreatePsbtPrintAble
+	psbt.FeeRate, err = getPsbtFeeRate(args.FeeRate)
+	if err != nil {
+		return CreatePsbtPrintAble{}, err
+	}
+	var trx transaction.Transaction
+	trx.Version = PsbtTrxVersion
+	var outputValue int64
+	fixedWeight := TrxOverheadWeight
+	for i, output := range args.Outputs {
+		scriptBytes, err := getScriptFromAddress(output.Address)
+		if err != nil {
+			return CreatePsbtPrintAble{}, errors.New("invalid address of output " + strconv.Itoa(i) + ": " + err.Error())
+		}
+		if output.Amount <= 0 || output.Amount > MaxMoney {
+			return CreatePsbtPrintAble{}, errors.New("invalid amount of output " + strconv.Itoa(i))
+		}
+		if output.Amount < getDustThreshold(scriptBytes) {
+			return CreatePsbtPrintAble{}, errors.New("amount of output " + strconv.Itoa(i) + " is dust")
+		}
+		outputValue += output.Amount
+		if outputValue > MaxMoney {
+			return CreatePsbtPrintAble{}, errors.New("total output amount out of range")
+		}
+		var txOut transaction.TxOut
+		txOut.Value = output.Amount
+		txOut.ScriptPubKey.SetScriptBytes(scriptBytes)
+		trx.Vout = append(trx.Vout, txOut)
+		fixedWeight += getOutputWeight(scriptBytes)
+	}
+	changeAddress := args.ChangeAddress
+	if changeAddress == "" {
+		changeAddress = addresses[0]
+	}
+	changeScript, err := getScriptFromAddress(changeAddress)
+	if err != nil {
+		return CreatePsbtPrintAble{}, errors.New("invalid change address: " + err.Error())
+	}
+
+	addressesUtxos, err := listUnSpentMulti(addresses)
+	if err != nil {
+		return CreatePsbtPrintAble{}, err
+	}
+	var utxos []UtxoPrintAble
+	for _, addressUtxos := range addressesUtxos {
+		utxos = append(utxos, addressUtxos.Utxos...)
+	}
+	if !args.IncludeLocked {
+		utxos = filterLockedUtxos(utxos)
+	}
+	candidates, err := getCoinCandidates(utxos, psbt.FeeRate, args.P2shScriptType)
+	if err != nil {
+		return CreatePsbtPrintAble{}, err
+	}
+	// the segwit marker is paid if any witness input may be selected, it is counted after the selection
+	markerWeight := 0
+	for _, candidate := range candidates {
+		if candidate.isWitness {
+			markerWeight = TrxSegWitMarkerWeight
+			break
+		}
+	}
+
+	// change costs its output now and its input when spent later
+	target := outputValue + getFeeOfWeight(fixedWeight+markerWeight, psbt.FeeRate)
+	changeOutputWeight := getOutputWeight(changeScript)
+	changeOutputFee := getFeeOfWeight(changeOutputWeight, psbt.FeeRate)
+	changeInputWeight, _, err := getInputWeight(changeScript, args.P2shScriptType)
+	if err != nil {
+		changeInputWeight = 148 * 4
+	}
+	costOfChange := changeOutputFee + getFeeOfWeight(changeInputWeight, psbt.FeeRate)
+
+	selected, ok := selectCoinsBnB(candidates, target, costOfChange)
+	psbt.Algorithm = CoinSelectAlgorithmBnB
+	if !ok {
+		selected, ok = selectCoinsKnapsack(candidates, target+changeOutputFee)
+		psbt.Algorithm = CoinSelectAlgorithmKnapsack
+	}
+	if !ok {
+		return CreatePsbtPrintAble{}, errors.New("insufficient funds")
+	}
+
+	var inputs []coinCandidate
+	var inputValue int64
+	var effectiveValue int64
+	isWitness := false
+	weight := fixedWeight
+	psbt.Inputs = []UtxoPrintAble{}
+	for _, index := range selected {
+		candidate := candidates[index]
+		var txIn transaction.TxIn
+		err = txIn.PrevOut.Hash.SetHex(candidate.utxo.TrxId)
+		if err != nil {
+			return CreatePsbtPrintAble{}, err
+		}
+		txIn.PrevOut.N = candidate.utxo.Vout
+		txIn.Sequence = PsbtSequenceRbf
+		trx.Vin = append(trx.Vin, txIn)
+		inputs = append(inputs, candidate)
+		inputValue += candidate.utxo.Amount
+		effectiveValue += candidate.effectiveValue
+		weight += candidate.inputWeight
+		isWitness = isWitness || candidate.isWitness
+		psbt.Inputs = append(psbt.Inputs, candidate.utxo)
+	}
+	if !isWitness {
+		markerWeight = 0
+	}
+	weight += markerWeight
+
+	psbt.ChangePosition = -1
+	if psbt.Algorithm == CoinSelectAlgorithmKnapsack {
+		// the fee of the marker goes back to the change if no witness input is selected
+		changeAmount := effectiveValue - outputValue - getFeeOfWeight(fixedWeight+markerWeight, psbt.FeeRate) - changeOutputFee
+		if changeAmount >= getDustThreshold(changeScript) {
+			var txOut transaction.TxOut
+			txOut.Value = changeAmount
+			txOut.ScriptPubKey.SetScriptBytes(changeScript)
+			trx.Vout = append(trx.Vout, txOut)
+			psbt.ChangePosition = len(trx.Vout) - 1
+			psbt.ChangeAmount = changeAmount
+			weight += changeOutputWeight
+		}
+	}
+	psbt.Fee = inputValue - outputValue - psbt.ChangeAmount
+	psbt.VSize = (weight + 3) / 4
+
+	psbt.Psbt, err = encodePsbt(&trx, inputs)
+	if err != nil {
+		return CreatePsbtPrintAble{}, err
+	}
+	return psbt, nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"github.com/mutalisk999/bitcoin-lib/src/transaction"
+	"testing"
+)
+
+// the taproot input carries witness_utxo only, so it is encoded without the db
+func TestEncodePsbt(t *testing.T) {
+	taprootScript, _ := hex.DecodeString("5120" + "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	outputScript, _ := hex.DecodeString("0014" + "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+
+	var input coinCandidate
+	input.utxo.TrxId = "0000000000000000000000000000000000000000000000000000000000000001"
+	input.utxo.Vout = 1
+	input.utxo.Amount = 100000
+	input.scriptPubKey = taprootScript
+	input.isWitness = true
+
+	var trx transaction.Transaction
+	trx.Version = PsbtTrxVersion
+	var txIn transaction.TxIn
+	err := txIn.PrevOut.Hash.SetHex(input.utxo.TrxId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txIn.PrevOut.N = input.utxo.Vout
+	txIn.Sequence = PsbtSequenceRbf
+	trx.Vin = append(trx.Vin, txIn)
+	var txOut transaction.TxOut
+	txOut.Value = 90000
+	txOut.ScriptPubKey.SetScriptBytes(outputScript)
+	trx.Vout = append(trx.Vout, txOut)
+
+	psbtStr, err := encodePsbt(&trx, []coinCandidate{input})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// magic, the unsigned trx, the witness utxo of the input and the empty output map
+	expected := "cHNidP8BAFICAAAAAQEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAD9////AZBfAQAAAAAAFgAUu7u7u7u7u7u7u7u7u7u7u7u7u7sAAAAAAAEBK6CGAQAAAAAAIlEgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqoAAA=="
+	if psbtStr != expected {
+		t.Fatalf("psbt %s, expected %s", psbtStr, expected)
+	}
+}
+
+func TestWritePsbtKeyValue(t *testing.T) {
+	bytesBuf := bytes.NewBuffer([]byte{})
+	err := writePsbtKeyValue(bytesBuf, PsbtInWitnessUtxo, []byte{0xab}, []byte{0x01, 0x02})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(bytesBuf.Bytes()) != "0201ab020102" {
+		t.Fatalf("key value %x", bytesBuf.Bytes())
+	}
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	RateLimitDefaultCost = 1
+	// the buckets are purged when there are so many clients, down to the retained count
+	RateLimitBucketsMax         = 10000
+	RateLimitBucketsRetainCount = 9000
+	// taken from the bucket of the client ip before the credentials are checked, refunded if they are valid
+	RateLimitAuthFailureCost = 10
+)
+
+var errRateLimited = errors.New("rate limited")
+
+// the history scans cost more than the point queries, overridden by rateLimit.methodCosts of the config,
+// the keys are the Service methods and the path templates of the http routes
+var defaultMethodCosts = map[string]float64{
+	"GetAddressTrxs":        10,
+	"GetAddressTrxsVerbose": 10,
+	"GetAddressesTrxs":      20,
+	"ListUnSpent":           5,
+	"ListUnSpentMulti":      20,
+	"GetAddressTxIds":       10,
+	"GetAddressUtxos":       5,
+	"GetAddressBalance":     10,
+	"ScanXpub":              50,
+	"ScanDescriptor":        50,
+	"CreatePsbt":            10,
+	"GetUtxoSetInfo":        10,
+	"VerifyIndex":           100,
+	"BackupDB":              100,
+	"StreamAddressTrxs":     10,
+
+	"/address/{addr}/txs":                                               10,
+	"/address/{addr}/utxo":                                              5,
+	"/insight-api/addr/{addr}":                                          10,
+	"/insight-api/addrs/{addrs}/utxo":                                   20,
+	"/api/address/{addr}":                                               10,
+	"/api/address/{addr}/txs":                                           5,
+	"/api/address/{addr}/txs/chain":                                     5,
+	"/api/address/{addr}/txs/chain/{last_seen_txid}":                    5,
+	"/api/address/{addr}/utxo":                                          5,
+	"/api/scripthash/{hash:[0-9a-fA-F]{64}}":                            10,
+	"/api/scripthash/{hash:[0-9a-fA-F]{64}}/txs":                        5,
+	"/api/scripthash/{hash:[0-9a-fA-F]{64}}/txs/chain":                  5,
+	"/api/scripthash/{hash:[0-9a-fA-F]{64}}/txs/chain/{last_seen_txid}": 5,
+	"/api/scripthash/{hash:[0-9a-fA-F]{64}}/utxo":                       5,
+}
+
+type tokenBucket struct {
+	tokens     float64
+	updateTime time.Time
+}
+
+type RateLimiter struct {
+	Mutex   *sync.Mutex
+	Buckets map[string]*tokenBucket
+}
+
+var rateLimiter = RateLimiter{new(sync.Mutex), make(map[string]*tokenBucket)}
+
+func isRateLimitEnabled() bool {
+	return config.RpcServerConfig.RateLimit.RequestsPerSecond > 0
+}
+
+func getMethodCost(methodName string) float64 {
+	cost, ok := config.RpcServerConfig.RateLimit.MethodCosts[methodName]
+	if ok {
+		return cost
+	}
+	cost, ok = defaultMethodCosts[methodName]
+	if ok {
+		return cost
+	}
+	return RateLimitDefaultCost
+}
+
+func getRateLimitBurst() float64 {
+	rateLimitConfig := &config.RpcServerConfig.RateLimit
+	if rateLimitConfig.Burst < rateLimitConfig.RequestsPerSecond {
+		return rateLimitConfig.RequestsPerSecond
+	}
+	return rateLimitConfig.Burst
+}
+
+func (l *RateLimiter) refill(bucket *tokenBucket, now time.Time, rate float64, burst float64) {
+	bucket.tokens = math.Min(burst, bucket.tokens+now.Sub(bucket.updateTime).Seconds()*rate)
+	bucket.updateTime = now
+}
+
+// purgeIdleBuckets removes the full buckets, which are the same as new ones,
+// then the least recently taken ones until the retained count
+func (l *RateLimiter) purgeIdleBuckets(now time.Time, rate float64, burst float64) {
+	clientKeys := make([]string, 0, len(l.Buckets))
+	for clientKey, bucket := range l.Buckets {
+		if bucket.tokens+now.Sub(bucket.updateTime).Seconds()*rate >= burst {
+			delete(l.Buckets, clientKey)
+			continue
+		}
+		clientKeys = append(clientKeys, clientKey)
+	}
+	if len(clientKeys) <= RateLimitBucketsRetainCount {
+		return
+	}
+	sort.Slice(clientKeys, func(i, j int) bool {
+		return l.Buckets[clientKeys[i]].updateTime.Before(l.Buckets[clientKeys[j]].updateTime)
+	})
+	for _, clientKey := range clientKeys[:len(clientKeys)-RateLimitBucketsRetainCount] {
+		delete(l.Buckets, clientKey)
+	}
+}
+
+// Take takes the tokens of the cost from the bucket of the client,
+// returns how long to wait for the tokens if not enough
+func (l *RateLimiter) Take(clientKey string, cost float64) (bool, time.Duration) {
+	if !isRateLimitEnabled() || cost <= 0 {
+		return true, 0
+	}
+	rate := config.RpcServerConfig.RateLimit.RequestsPerSecond
+	burst := getRateLimitBurst()
+	// a cost above the burst could never be taken
+	cost = math.Min(cost, burst)
+
+	l.Mutex.Lock()
+	defer l.Mutex.Unlock()
+	now := time.Now()
+	bucket, ok := l.Buckets[clientKey]
+	if !ok {
+		if len(l.Buckets) >= RateLimitBucketsMax {
+			l.purgeIdleBuckets(now, rate, burst)
+		}
+		bucket = &tokenBucket{burst, now}
+		l.Buckets[clientKey] = bucket
+	}
+	l.refill(bucket, now, rate, burst)
+	if bucket.tokens < cost {
+		return false, time.Duration((cost - bucket.tokens) / rate * float64(time.Second))
+	}
+	bucket.tokens -= cost
+	return true, 0
+}
+
+// Refund returns the tokens taken for a cost which is not charged
+func (l *RateLimiter) Refund(clientKey string, cost float64) {
+	if !isRateLimitEnabled() || cost <= 0 {
+		return
+	}
+	burst := getRateLimitBurst()
+	cost = math.Min(cost, burst)
+
+	l.Mutex.Lock()
+	defer l.Mutex.Unlock()
+	bucket, ok := l.Buckets[clientKey]
+	if ok {
+		bucket.tokens = math.Min(burst, bucket.tokens+cost)
+	}
+}
+
+// getRateLimitClientKey limits an authenticated client by its name, or else by its ip
+func getRateLimitClientKey(principal *AuthPrincipal, remoteAddr string) string {
+	if principal != nil && principal.Name != "" {
+		return "name:" + principal.Name
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	return "ip:" + host
+}
+
+func getRetryAfterSeconds(retryAfter time.Duration) int {
+	return int(math.Ceil(retryAfter.Seconds()))
+}
+
+func getRetryAfterMessage(retryAfter time.Duration) string {
+	return "rate limited, retry after " + strconv.Itoa(int(math.Ceil(float64(retryAfter)/float64(time.Millisecond)))) + " ms"
+}
+
+// authenticateRateLimited limits the failed authentications by the client ip,
+// the cost is taken before the credentials are checked so that the checks are limited too
+func authenticateRateLimited(remoteAddr string, authenticateFunc func() (*AuthPrincipal, error)) (*AuthPrincipal, time.Duration, error) {
+	if !isAuthEnabled() {
+		principal, err := authenticateFunc()
+		return principal, 0, err
+	}
+	// a bucket apart from the requests of the ip
+	clientKey := "auth:" + getRateLimitClientKey(nil, remoteAddr)
+	ok, retryAfter := rateLimiter.Take(clientKey, RateLimitAuthFailureCost)
+	if !ok {
+		return nil, retryAfter, errRateLimited
+	}
+	principal, err := authenticateFunc()
+	if err == nil {
+		rateLimiter.Refund(clientKey, RateLimitAuthFailureCost)
+	}
+	return principal, 0, err
+}
+
+func takeHttpRateLimit(r *http.Request, methodName string) (bool, time.Duration) {
+	return rateLimiter.Take(getRateLimitClientKey(getAuthPrincipal(r), r.RemoteAddr), getMethodCost(methodName))
+}
+
+func writeTooManyRequests(w http.ResponseWriter, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(getRetryAfterSeconds(retryAfter)))
+	http.Error(w, getRetryAfterMessage(retryAfter), http.StatusTooManyRequests)
+}
+
+// rateLimitHandler limits the rest routes by their path templates,
+// the rpc route "/" is limited by the rpc method in JsonRpc2Handler
+func rateLimitHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			pathTemplate := r.URL.Path
+			route := mux.CurrentRoute(r)
+			if route != nil {
+				pathTemplate, _ = route.GetPathTemplate()
+			}
+			ok, retryAfter := takeHttpRateLimit(r, pathTemplate)
+			if !ok {
+				writeTooManyRequests(w, retryAfter)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func getGrpcRemoteAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	return p.Addr.String()
+}
+
+// takeGrpcRateLimit sets the retry-after header in seconds if the client is limited
+func takeGrpcRateLimit(ctx context.Context, principal *AuthPrincipal, methodName string, setHeader func(metadata.MD) error) error {
+	ok, retryAfter := rateLimiter.Take(getRateLimitClientKey(principal, getGrpcRemoteAddr(ctx)), getMethodCost(methodName))
+	if ok {
+		return nil
+	}
+	_ = setHeader(metadata.Pairs("retry-after", strconv.Itoa(getRetryAfterSeconds(retryAfter))))
+	return status.Error(codes.ResourceExhausted, getRetryAfterMessage(retryAfter))
+}
+
+func takeGrpcUnaryRateLimit(ctx context.Context, principal *AuthPrincipal, methodName string) error {
+	return takeGrpcRateLimit(ctx, principal, methodName, func(md metadata.MD) error {
+		return grpc.SetHeader(ctx, md)
+	})
+}
+
+func takeGrpcStreamRateLimit(ss grpc.ServerStream, principal *AuthPrincipal, methodName string) error {
+	return takeGrpcRateLimit(ss.Context(), principal, methodName, ss.SetHeader)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newRateLimitTestLimiter(requestsPerSecond float64, burst float64) (*RateLimiter, func()) {
+	rateLimitConfig := config.RpcServerConfig.RateLimit
+	config.RpcServerConfig.RateLimit = RateLimitConfig{RequestsPerSecond: requestsPerSecond, Burst: burst}
+	return &RateLimiter{new(sync.Mutex), make(map[string]*tokenBucket)}, func() {
+		config.RpcServerConfig.RateLimit = rateLimitConfig
+	}
+}
+
+func TestRateLimiterTake(t *testing.T) {
+	limiter, restore := newRateLimitTestLimiter(10, 20)
+	defer restore()
+	for _, vector := range []struct {
+		// seconds since the last take
+		elapsed    float64
+		cost       float64
+		ok         bool
+		retryAfter time.Duration
+	}{
+		// a new client starts with the burst
+		{0, 15, true, 0},
+		{0, 5, true, 0},
+		{0, 5, false, 500 * time.Millisecond},
+		// refilled by the rate
+		{0.2, 5, false, 300 * time.Millisecond},
+		{0.5, 5, true, 0},
+		// never above the burst
+		{60, 20, true, 0},
+		{0, 1, false, 100 * time.Millisecond},
+		// a cost above the burst costs the burst
+		{60, 50, true, 0},
+		{0, 50, false, 2 * time.Second},
+	} {
+		bucket, ok := limiter.Buckets["ip:1.2.3.4"]
+		if ok {
+			bucket.updateTime = bucket.updateTime.Add(-time.Duration(vector.elapsed * float64(time.Second)))
+		}
+		isTaken, retryAfter := limiter.Take("ip:1.2.3.4", vector.cost)
+		if isTaken != vector.ok {
+			t.Fatalf("elapsed %v cost %v: taken %v", vector.elapsed, vector.cost, isTaken)
+		}
+		// the wait is shortened by the time passed in the test
+		if retryAfter > vector.retryAfter || retryAfter < vector.retryAfter-50*time.Millisecond {
+			t.Fatalf("elapsed %v cost %v: retry after %v, expected %v", vector.elapsed, vector.cost, retryAfter, vector.retryAfter)
+		}
+	}
+
+	// the seconds of the Retry-After header are rounded up
+	for _, vector := range []struct {
+		retryAfter time.Duration
+		seconds    int
+	}{
+		{100 * time.Millisecond, 1},
+		{time.Second, 1},
+		{1500 * time.Millisecond, 2},
+	} {
+		if getRetryAfterSeconds(vector.retryAfter) != vector.seconds {
+			t.Fatalf("retry after %v: %d seconds", vector.retryAfter, getRetryAfterSeconds(vector.retryAfter))
+		}
+	}
+}
+
+func TestRateLimiterPurge(t *testing.T) {
+	limiter, restore := newRateLimitTestLimiter(1, 10)
+	defer restore()
+	for i := 0; i < RateLimitBucketsMax; i++ {
+		limiter.Take("ip:"+strconv.Itoa(i), 1)
+	}
+	// a full bucket is purged, the oldest ones are evicted down to the retained count
+	limiter.Buckets["ip:0"].tokens = 10
+	limiter.Buckets["ip:1"].updateTime = limiter.Buckets["ip:1"].updateTime.Add(-time.Second / 2)
+	limiter.Take("ip:new", 1)
+	if len(limiter.Buckets) != RateLimitBucketsRetainCount+1 {
+		t.Fatalf("buckets %d", len(limiter.Buckets))
+	}
+	for _, clientKey := range []string{"ip:0", "ip:1"} {
+		if _, ok := limiter.Buckets[clientKey]; ok {
+			t.Fatalf("bucket %s is not purged", clientKey)
+		}
+	}
+	if _, ok := limiter.Buckets["ip:"+strconv.Itoa(RateLimitBucketsMax-1)]; !ok {
+		t.Fatal("the newest bucket is purged")
+	}
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/mutalisk999/bitcoin-lib/src/bigint"
+	"strconv"
+	"strings"
+)
+
+func getLastTrxSeqOfBlock(blockHeight uint32) (uint32, error) {
+	if blockHeight == 0 {
+		// the genesis block is never indexed
+		return 0, nil
+	}
+	blockInfo, err := blockDBMgr.DBGet(blockHeight)
+	if err != nil {
+		return 0, errors.New("block info not found, height: " + strconv.Itoa(int(blockHeight)))
+	}
+	return blockInfo.FirstTrxSeq + blockInfo.TrxCount - 1, nil
+}
+
+// checkReindexBlocks checks the block infos above the height cover the trxs up to the trx sequence,
+// and the trxs except the coinbases have the undo data to restore the spent utxos
+func checkReindexBlocks(blockHeight uint32, startHeight uint32, lastTrxSeq uint32, trxSequence uint32) error {
+	nextTrxSeq := lastTrxSeq + 1
+	for height := blockHeight + 1; height <= startHeight; height++ {
+		blockInfo, err := blockDBMgr.DBGet(height)
+		if err != nil {
+			return errors.New("block info not found, height: " + strconv.Itoa(int(height)))
+		}
+		if blockInfo.FirstTrxSeq != nextTrxSeq {
+			return errors.New("trx seqs of block are not continuous, height: " + strconv.Itoa(int(height)))
+		}
+		for i := uint32(1); i < blockInfo.TrxCount; i++ {
+			trxSeq := blockInfo.FirstTrxSeq + i
+			trxId, err := trxSeqDBMgr.DBGet(trxSeq)
+			if err != nil {
+				return errors.New("trx seq not found: " + strconv.Itoa(int(trxSeq)))
+			}
+			_, err = trxUndoDBMgr.DBGet(trxId)
+			if err != nil {
+				return errors.New("trx undo not found: " + trxId.GetHex() + ", can not reindex below block height " + strconv.Itoa(int(height)))
+			}
+		}
+		nextTrxSeq += blockInfo.TrxCount
+	}
+	if nextTrxSeq != trxSequence+1 {
+		return errors.New("trx seqs of blocks do not match the trx sequence " + strconv.Itoa(int(trxSequence)))
+	}
+	return nil
+}
+
+// rollbackBlock rolls back the trxs of the block from the last one, one trx is loaded at a time
+func rollbackBlock(blockHeight uint32, blockInfo *BlockInfo) error {
+	for i := blockInfo.TrxCount; i > 0; i-- {
+		trxSeq := blockInfo.FirstTrxSeq + i - 1
+		trxId, err := trxSeqDBMgr.DBGet(trxSeq)
+		if err != nil {
+			return errors.New("trx seq not found: " + strconv.Itoa(int(trxSeq)))
+		}
+		var spentUtxos []SpentUtxo
+		if i != 1 {
+			// the spent utxos of the trx should be restored, the first trx is the coinbase
+			spentUtxos, err = trxUndoDBMgr.DBGet(trxId)
+			if err != nil {
+				return errors.New("trx undo not found: " + trxId.GetHex())
+			}
+		}
+		err = rollbackTrx(blockHeight, trxSeq, trxId, spentUtxos)
+		if err != nil {
+			return err
+		}
+	}
+	err := blockHashDBMgr.DBDelete(blockInfo.BlockHash)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func rollbackTrx(blockHeight uint32, trxSeq uint32, trxId bigint.Uint256, spentUtxos []SpentUtxo) error {
+	// remove the utxos created by the trx
+	var utxoSrcs []UtxoSource
+	err := utxoDBMgr.DBIterateTrxId(trxId, func(utxoSrc UtxoSource, utxoDetail UtxoDetail) error {
+		utxoSrcs = append(utxoSrcs, utxoSrc)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, utxoSrc := range utxoSrcs {
+		err = utxoDBMgr.DBDelete(utxoSrc)
+		if err != nil {
+			return err
+		}
+	}
+
+	// restore the utxos spent by the trx, if they are created under the height
+	for _, spentUtxo := range spentUtxos {
+		if spentUtxo.UtxoDetail.BlockHeight > blockHeight {
+			continue
+		}
+		err = utxoDBMgr.DBPut(spentUtxo.UtxoSource, spentUtxo.UtxoDetail)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = trxSeqDBMgr.DBDelete(trxSeq)
+	if err != nil {
+		return err
+	}
+	err = trxLocDBMgr.DBDelete(trxId)
+	if err != nil {
+		return err
+	}
+	err = trxUndoDBMgr.DBDelete(trxId)
+	if err != nil {
+		return err
+	}
+	err = rawTrxDBMgr.DBDelete(trxId)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func rollbackAddrTrxs(blockHeight uint32, lastTrxSeq uint32) error {
+	// the key suffix is the height of the flush, entries up to the height do not contain removed trxs.
+	// the kept trxs of the entries above are moved to the suffix of the height, the later flushes
+	// write the suffixes above it again
+	keysDel := []string{}
+	keysPut := make(map[string][]uint32)
+	heightSuffix := "." + strconv.Itoa(int(blockHeight))
+	err := addrTrxsDBMgr.DBIterate(func(key string, trxSeqs []uint32) error {
+		pos := strings.LastIndex(key, ".")
+		if pos < 0 {
+			return nil
+		}
+		ui64, err := strconv.ParseUint(key[pos+1:], 10, 32)
+		if err != nil || uint32(ui64) <= blockHeight {
+			return nil
+		}
+		trxSeqsNew := make([]uint32, 0, len(trxSeqs))
+		for _, trxSeq := range trxSeqs {
+			if trxSeq <= lastTrxSeq {
+				trxSeqsNew = append(trxSeqsNew, trxSeq)
+			}
+		}
+		keysDel = append(keysDel, key)
+		if len(trxSeqsNew) != 0 {
+			keyNew := key[:pos] + heightSuffix
+			keysPut[keyNew] = append(keysPut[keyNew], trxSeqsNew...)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keysDel {
+		err = addrTrxsDBMgr.DBDelete(key)
+		if err != nil {
+			return err
+		}
+	}
+	for key, trxSeqs := range keysPut {
+		trxSeqsOld, err := addrTrxsDBMgr.DBGet(key)
+		if err != nil {
+			if err.Error() != NotFoundError {
+				return err
+			}
+		} else {
+			trxSeqs = append(trxSeqsOld, trxSeqs...)
+		}
+		err = addrTrxsDBMgr.DBPut(key, trxSeqs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func reindexFromHeight(blockHeight uint32) error {
+	flushMutex.Lock()
+	defer flushMutex.Unlock()
+
+	startHeight, err := getStartBlockHeight()
+	if err != nil {
+		return err
+	}
+	trxSequence, err := getStartTrxSequence()
+	if err != nil {
+		return err
+	}
+	if blockHeight >= startHeight {
+		return errors.New("reindex height should be lower than the indexed height " + strconv.Itoa(int(startHeight)))
+	}
+	if !config.GatherConfig.StoreTrxUndo {
+		return errors.New("trx undo data is not stored, can not reindex")
+	}
+	undoHeight, err := getTrxUndoHeight()
+	if err != nil {
+		return err
+	}
+	if blockHeight < undoHeight {
+		return errors.New("trx undo data is missing up to the snapshot height " + strconv.Itoa(int(undoHeight)) + ", can not reindex below it")
+	}
+	lastTrxSeq, err := getLastTrxSeqOfBlock(blockHeight)
+	if err != nil {
+		return err
+	}
+
+	// check the block infos needed before modifying anything
+	err = checkReindexBlocks(blockHeight, startHeight, lastTrxSeq, trxSequence)
+	if err != nil {
+		return err
+	}
+
+	// roll back block by block from the tip
+	err = storeChainIndexState("0")
+	if err != nil {
+		return err
+	}
+	for height := startHeight; height > blockHeight; height-- {
+		blockInfo, err := blockDBMgr.DBGet(height)
+		if err != nil {
+			return err
+		}
+		err = rollbackBlock(blockHeight, &blockInfo)
+		if err != nil {
+			return err
+		}
+		err = blockDBMgr.DBDelete(height)
+		if err != nil {
+			return err
+		}
+	}
+	err = rollbackAddrTrxs(blockHeight, lastTrxSeq)
+	if err != nil {
+		return err
+	}
+
+	err = storeStartBlockHeight(blockHeight)
+	if err != nil {
+		return err
+	}
+	err = storeStartTrxSequence(lastTrxSeq)
+	if err != nil {
+		return err
+	}
+	pruneHeight, err := getRawTrxPruneHeight()
+	if err != nil {
+		return err
+	}
+	if pruneHeight > blockHeight {
+		err = storeRawTrxPruneHeight(blockHeight)
+		if err != nil {
+			return err
+		}
+	}
+	if blockHeight == 0 {
+		// nothing is left, the db is indexed again by the current version
+		err = storeIndexVersion(IndexVersion)
+		if err != nil {
+			return err
+		}
+	}
+	utxoSetInfo, err = rebuildUtxoSetInfo()
+	if err != nil {
+		return err
+	}
+	err = storeChainIndexState("1")
+	if err != nil {
+		return err
+	}
+	fmt.Println("reindex removed", trxSequence-lastTrxSeq, "trxs above block height", blockHeight)
+	return nil
+}
+
+func parseReindexArgs(args []string) (uint32, error) {
+	if len(args) != 2 || args[0] != "--from" {
+		return 0, errors.New("usage: reindex --from <height>")
+	}
+	ui64, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(ui64), nil
+}
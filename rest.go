@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/mux"
+	"github.com/mutalisk999/bitcoin-lib/src/bigint"
+	"net/http"
+	"strconv"
+)
+
+type RestErrorPrintAble struct {
+	Error string
+}
+
+type RestStatusPrintAble struct {
+	BlockHeight uint32
+	BlockHash   string
+	TrxCount    uint32
+}
+
+func writeRestJson(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeRestError maps the not found errors of the service to 404
+func writeRestError(w http.ResponseWriter, status int, err error) {
+	if status == 0 {
+		status = http.StatusInternalServerError
+		if errors.Is(err, errNotFound) {
+			status = http.StatusNotFound
+		}
+	}
+	writeRestJson(w, status, RestErrorPrintAble{err.Error()})
+}
+
+func getRestTrxId(r *http.Request) (string, error) {
+	trxIdStr := mux.Vars(r)["txid"]
+	var trxId bigint.Uint256
+	if len(trxIdStr) != 64 || trxId.SetHex(trxIdStr) != nil {
+		return "", errors.New("invalid transaction id")
+	}
+	return trxIdStr, nil
+}
+
+func restGetAddressTrxs(w http.ResponseWriter, r *http.Request) {
+	addrStr := mux.Vars(r)["addr"]
+	trxs := []TrxStatusPrintAble{}
+	err := new(Service).GetAddressTrxsVerbose(r, &addrStr, &trxs)
+	if err != nil {
+		writeRestError(w, 0, err)
+		return
+	}
+	writeRestJson(w, http.StatusOK, trxs)
+}
+
+func restGetAddressUtxos(w http.ResponseWriter, r *http.Request) {
+	addrStr := mux.Vars(r)["addr"]
+	trxSeqs, err := getAddressTrxSeqs(addrStr)
+	if err != nil {
+		writeRestError(w, 0, err)
+		return
+	}
+	utxos, err := getAddressUtxos(addrStr, trxSeqs)
+	if err != nil {
+		writeRestError(w, 0, err)
+		return
+	}
+	writeRestJson(w, http.StatusOK, filterLockedUtxos(utxos))
+}
+
+func restGetTrx(w http.ResponseWriter, r *http.Request) {
+	trxIdStr, err := getRestTrxId(r)
+	if err != nil {
+		writeRestError(w, http.StatusBadRequest, err)
+		return
+	}
+	var trxVerbose TrxVerbosePrintAble
+	err = new(Service).GetTrxVerbose(r, &trxIdStr, &trxVerbose)
+	if err != nil {
+		writeRestError(w, 0, err)
+		return
+	}
+	writeRestJson(w, http.StatusOK, trxVerbose)
+}
+
+func restGetRawTrx(w http.ResponseWriter, r *http.Request) {
+	trxIdStr, err := getRestTrxId(r)
+	if err != nil {
+		writeRestError(w, http.StatusBadRequest, err)
+		return
+	}
+	var rawTrx string
+	err = new(Service).GetRawTrx(r, &trxIdStr, &rawTrx)
+	if err != nil {
+		writeRestError(w, 0, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(rawTrx))
+}
+
+func restGetUtxo(w http.ResponseWriter, r *http.Request) {
+	trxIdStr, err := getRestTrxId(r)
+	if err != nil {
+		writeRestError(w, http.StatusBadRequest, err)
+		return
+	}
+	vout, err := strconv.ParseUint(mux.Vars(r)["vout"], 10, 32)
+	if err != nil {
+		writeRestError(w, http.StatusBadRequest, errors.New("invalid vout"))
+		return
+	}
+	utxoSrc := UtxoSourcePrintAble{trxIdStr, uint32(vout)}
+	var utxoDetail UtxoDetailPrintAble
+	err = new(Service).GetUtxo(r, &utxoSrc, &utxoDetail)
+	if err != nil {
+		writeRestError(w, 0, err)
+		return
+	}
+	writeRestJson(w, http.StatusOK, UtxoPrintAble{utxoSrc, utxoDetail})
+}
+
+func restGetBlock(w http.ResponseWriter, r *http.Request) {
+	heightStr := mux.Vars(r)["height"]
+	_, err := strconv.ParseUint(heightStr, 10, 32)
+	if err != nil {
+		writeRestError(w, http.StatusBadRequest, errors.New("invalid block height"))
+		return
+	}
+	var args BlockQueryArgs
+	args.HeightOrHash = heightStr
+	var blockPrintAble BlockPrintAble
+	err = new(Service).GetBlock(r, &args, &blockPrintAble)
+	if err != nil {
+		writeRestError(w, 0, err)
+		return
+	}
+	writeRestJson(w, http.StatusOK, blockPrintAble)
+}
+
+func restGetStatus(w http.ResponseWriter, r *http.Request) {
+	var status RestStatusPrintAble
+	// the flushed block is in the db, its trxs are counted up to its last one
+	status.BlockHeight = getFlushedBlockHeight()
+	blockInfo, err := blockDBMgr.DBGet(status.BlockHeight)
+	if err == nil {
+		status.BlockHash = blockInfo.BlockHash.GetHex()
+		status.TrxCount = blockInfo.FirstTrxSeq + blockInfo.TrxCount - 1
+	}
+	writeRestJson(w, http.StatusOK, status)
+}
+
+func registerRestHandlers(urlRouter *mux.Router) {
+	urlRouter.HandleFunc("/address/{addr}/txs", restGetAddressTrxs).Methods("GET")
+	urlRouter.HandleFunc("/address/{addr}/utxo", restGetAddressUtxos).Methods("GET")
+	urlRouter.HandleFunc("/tx/{txid}", restGetTrx).Methods("GET")
+	urlRouter.HandleFunc("/tx/{txid}/hex", restGetRawTrx).Methods("GET")
+	urlRouter.HandleFunc("/utxo/{txid}/{vout}", restGetUtxo).Methods("GET")
+	urlRouter.HandleFunc("/block/{height}", restGetBlock).Methods("GET")
+	urlRouter.HandleFunc("/status", restGetStatus).Methods("GET")
+}
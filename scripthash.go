@@ -0,0 +1,74 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// calcScriptHash is the sha256 of the script pubkey, as the esplora scripthash
+func calcScriptHash(scriptBytes []byte) []byte {
+	scriptHash := sha256.Sum256(scriptBytes)
+	return scriptHash[0:]
+}
+
+// indexAddressScriptHash maps the script hash to the address, the addresses without standard script are skipped
+func indexAddressScriptHash(addrStr string) error {
+	scriptBytes, err := getScriptFromAddress(addrStr)
+	if err != nil {
+		return nil
+	}
+	return scriptHashDBMgr.DBPut(calcScriptHash(scriptBytes), addrStr)
+}
+
+func getAddressByScriptHash(scriptHashHex string) (string, error) {
+	scriptHash, err := hex.DecodeString(scriptHashHex)
+	if err != nil || len(scriptHash) != sha256.Size {
+		return "", errors.New("invalid script hash")
+	}
+	addrStr, err := scriptHashDBMgr.DBGet(scriptHash)
+	if err != nil {
+		if err.Error() == NotFoundError {
+			return "", newNotFoundError("script hash")
+		}
+		return "", err
+	}
+	return addrStr, nil
+}
+
+func rebuildScriptHashIndex() error {
+	var count uint32
+	lastAddrStr := ""
+	err := addrTrxsDBMgr.DBIterate(func(key string, trxSeqs []uint32) error {
+		// key is address.blockHeight, the keys of the same address are adjacent
+		index := strings.LastIndex(key, ".")
+		if index < 0 {
+			return nil
+		}
+		addrStr := key[0:index]
+		if addrStr == lastAddrStr {
+			return nil
+		}
+		lastAddrStr = addrStr
+		count += 1
+		return indexAddressScriptHash(addrStr)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println("script hash index built, addresses:", count)
+	return globalConfigDBMgr.DBPut("scriptHashIndexState", "1")
+}
+
+func initScriptHashIndex() error {
+	state, err := globalConfigDBMgr.DBGet("scriptHashIndexState")
+	if err == nil && state == "1" {
+		return nil
+	}
+	if err != nil && err.Error() != NotFoundError {
+		return err
+	}
+	return rebuildScriptHashIndex()
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/bech32"
+	"strings"
+)
+
+const (
+	SegWitHrpMainNet = "bc"
+	SegWitHrpTestNet = "tb"
+	SegWitHrpRegTest = "bcrt"
+	bech32Const      = 1
+	bech32mConst     = 0x2bc830a3
+)
+
+// encodeSegWitAddress encodes the witness program with bech32 for version 0 (BIP173)
+// and with bech32m for version 1 and above (BIP350)
+func encodeSegWitAddress(hrp string, version byte, program []byte) (string, error) {
+	if version > 16 {
+		return "", errors.New("invalid witness version")
+	}
+	if len(program) < 2 || len(program) > 40 {
+		return "", errors.New("invalid witness program size")
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return "", errors.New("invalid witness v0 program size")
+	}
+	checkSumConst := uint32(bech32Const)
+	if version != 0 {
+		checkSumConst = bech32mConst
+	}
+	data := append([]byte{version}, bech32.Bytes8to5(program)...)
+	values := append(bech32.HRPExpand(hrp), data...)
+	values = append(values, make([]byte, 6)...)
+	checkSum := bech32.PolyMod(values) ^ checkSumConst
+	for i := 0; i < 6; i++ {
+		data = append(data, byte(checkSum>>(5*(5-uint32(i))))&0x1f)
+	}
+	dataStr, err := bech32.SquashedBytesToString(data)
+	if err != nil {
+		return "", err
+	}
+	return hrp + "1" + dataStr, nil
+}
+
+// decodeSegWitAddress checks the bech32 or bech32m checksum by the witness version, returns the version and program
+func decodeSegWitAddress(hrp string, addr string) (byte, []byte, error) {
+	if len(addr) > 90 {
+		return 0, nil, errors.New("invalid segwit address size")
+	}
+	lowerAddr := strings.ToLower(addr)
+	if addr != lowerAddr && addr != strings.ToUpper(addr) {
+		return 0, nil, errors.New("mixed case segwit address")
+	}
+	sepIndex := strings.LastIndex(lowerAddr, "1")
+	if sepIndex < 1 || lowerAddr[0:sepIndex] != hrp || len(lowerAddr)-sepIndex-1 < 7 {
+		return 0, nil, errors.New("invalid segwit address hrp")
+	}
+	data, err := bech32.StringToSquashedBytes(lowerAddr[sepIndex+1:])
+	if err != nil {
+		return 0, nil, err
+	}
+	version := data[0]
+	checkSumConst := uint32(bech32Const)
+	if version != 0 {
+		checkSumConst = bech32mConst
+	}
+	if bech32.PolyMod(append(bech32.HRPExpand(hrp), data...)) != checkSumConst {
+		return 0, nil, errors.New("invalid segwit address checksum")
+	}
+	if version > 16 {
+		return 0, nil, errors.New("invalid witness version")
+	}
+	program, err := bech32.Bytes5to8(data[1 : len(data)-6])
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(program) < 2 || len(program) > 40 {
+		return 0, nil, errors.New("invalid witness program size")
+	}
+	if version == 0 && len(program) != 20 && len(program) != 32 {
+		return 0, nil, errors.New("invalid witness v0 program size")
+	}
+	return version, program, nil
+}
+
+func getSegWitScript(version byte, program []byte) []byte {
+	opVersion := version
+	if version != 0 {
+		opVersion = version + 0x50
+	}
+	return append([]byte{opVersion, byte(len(program))}, program...)
+}
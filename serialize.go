@@ -115,6 +115,26 @@ func utxoDetailFromBytes(bytesUtxoDetail []byte) (UtxoDetail, error) {
 	return utxoDetail, nil
 }
 
+func utxoLockToBytes(utxoLock UtxoLock) ([]byte, error) {
+	bytesBuf := bytes.NewBuffer([]byte{})
+	bufWriter := io.Writer(bytesBuf)
+	err := utxoLock.Pack(bufWriter)
+	if err != nil {
+		return []byte{}, err
+	}
+	return bytesBuf.Bytes(), nil
+}
+
+func utxoLockFromBytes(bytesUtxoLock []byte) (UtxoLock, error) {
+	var utxoLock UtxoLock
+	bufReader := io.Reader(bytes.NewBuffer(bytesUtxoLock))
+	err := utxoLock.UnPack(bufReader)
+	if err != nil {
+		return UtxoLock{}, err
+	}
+	return utxoLock, nil
+}
+
 func uint256ToBytes(uint256 bigint.Uint256) ([]byte, error) {
 	bytesBuf := bytes.NewBuffer([]byte{})
 	bufWriter := io.Writer(bytesBuf)
@@ -154,3 +174,77 @@ func uint32FromBytes(bytesUint32 []byte) (uint32, error) {
 	}
 	return ui32, nil
 }
+
+func spentUtxosToBytes(spentUtxos []SpentUtxo) ([]byte, error) {
+	bytesBuf := bytes.NewBuffer([]byte{})
+	bufWriter := io.Writer(bytesBuf)
+	err := serialize.PackCompactSize(bufWriter, uint64(len(spentUtxos)))
+	if err != nil {
+		return []byte{}, err
+	}
+	for _, spentUtxo := range spentUtxos {
+		err = spentUtxo.Pack(bufWriter)
+		if err != nil {
+			return []byte{}, err
+		}
+	}
+	return bytesBuf.Bytes(), nil
+}
+
+func spentUtxosFromBytes(bytesSpentUtxos []byte) ([]SpentUtxo, error) {
+	bufReader := io.Reader(bytes.NewBuffer(bytesSpentUtxos))
+	ui64, err := serialize.UnPackCompactSize(bufReader)
+	if err != nil {
+		return []SpentUtxo{}, err
+	}
+	spentUtxos := make([]SpentUtxo, ui64, ui64)
+	for i := 0; i < int(ui64); i++ {
+		var spentUtxo SpentUtxo
+		err = spentUtxo.UnPack(bufReader)
+		if err != nil {
+			return []SpentUtxo{}, err
+		}
+		spentUtxos[i] = spentUtxo
+	}
+	return spentUtxos, nil
+}
+
+func trxLocationToBytes(trxLocation TrxLocation) ([]byte, error) {
+	bytesBuf := bytes.NewBuffer([]byte{})
+	bufWriter := io.Writer(bytesBuf)
+	err := trxLocation.Pack(bufWriter)
+	if err != nil {
+		return []byte{}, err
+	}
+	return bytesBuf.Bytes(), nil
+}
+
+func trxLocationFromBytes(bytesTrxLocation []byte) (TrxLocation, error) {
+	var trxLocation TrxLocation
+	bufReader := io.Reader(bytes.NewBuffer(bytesTrxLocation))
+	err := trxLocation.UnPack(bufReader)
+	if err != nil {
+		return TrxLocation{}, err
+	}
+	return trxLocation, nil
+}
+
+func blockInfoToBytes(blockInfo BlockInfo) ([]byte, error) {
+	bytesBuf := bytes.NewBuffer([]byte{})
+	bufWriter := io.Writer(bytesBuf)
+	err := blockInfo.Pack(bufWriter)
+	if err != nil {
+		return []byte{}, err
+	}
+	return bytesBuf.Bytes(), nil
+}
+
+func blockInfoFromBytes(bytesBlockInfo []byte) (BlockInfo, error) {
+	var blockInfo BlockInfo
+	bufReader := io.Reader(bytes.NewBuffer(bytesBlockInfo))
+	err := blockInfo.UnPack(bufReader)
+	if err != nil {
+		return BlockInfo{}, err
+	}
+	return blockInfo, nil
+}
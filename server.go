@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/rpc"
 	"github.com/gorilla/rpc/json"
@@ -17,6 +18,13 @@ import (
 type Service struct {
 }
 
+// errNotFound is wrapped by the errors of the missing objects, the servers map it to their not found status
+var errNotFound = errors.New("not found")
+
+func newNotFoundError(name string) error {
+	return fmt.Errorf("%s %w", name, errNotFound)
+}
+
 func (s *Service) GetBlockCount(r *http.Request, args *interface{}, reply *uint32) error {
 	*reply = startBlockHeight
 	return nil
@@ -30,7 +38,7 @@ func (s *Service) GetTrxCount(r *http.Request, args *interface{}, reply *uint32)
 func (s *Service) GetTrxIdBySeq(r *http.Request, args *uint32, reply *string) error {
 	trxId, err := trxSeqDBMgr.DBGet(*args)
 	if err != nil {
-		return errors.New("trx seq not found")
+		return newNotFoundError("trx seq")
 	}
 	*reply = trxId.GetHex()
 	return nil
@@ -39,7 +47,7 @@ func (s *Service) GetTrxIdBySeq(r *http.Request, args *uint32, reply *string) er
 func (s *Service) GetAddressTrxs(r *http.Request, args *string, reply *[]string) error {
 	trxSeqs, err := addrTrxsDBMgr.DBGetPrefix(*args + ".")
 	if err != nil {
-		return errors.New("address not found")
+		return newNotFoundError("address")
 	}
 	for _, trxSeq := range trxSeqs {
 		trxId, err := trxSeqDBMgr.DBGet(trxSeq)
@@ -52,29 +60,73 @@ func (s *Service) GetAddressTrxs(r *http.Request, args *string, reply *[]string)
 	return nil
 }
 
+func (s *Service) GetAddressTrxsVerbose(r *http.Request, args *string, reply *[]TrxStatusPrintAble) error {
+	trxSeqs, err := addrTrxsDBMgr.DBGetPrefix(*args + ".")
+	if err != nil {
+		return newNotFoundError("address")
+	}
+	for _, trxSeq := range trxSeqs {
+		trxStatus, err := getTrxStatusBySeq(trxSeq)
+		if err != nil {
+			continue
+		}
+		*reply = append(*reply, trxStatus)
+	}
+	return nil
+}
+
+func (s *Service) GetTrxStatus(r *http.Request, args *string, reply *TrxStatusPrintAble) error {
+	var trxId bigint.Uint256
+	err := trxId.SetHex(*args)
+	if err != nil {
+		return err
+	}
+	trxStatus, err := getTrxStatusPrintAble(trxId)
+	if err != nil {
+		return newNotFoundError("transaction id")
+	}
+	*reply = trxStatus
+	return nil
+}
+
+func (s *Service) GetBlock(r *http.Request, args *BlockQueryArgs, reply *BlockPrintAble) error {
+	blockHeight, blockInfo, err := getBlockInfo(args.HeightOrHash)
+	if err != nil {
+		return err
+	}
+	blockPrintAble := blockInfo.GetBlockPrintAble(blockHeight)
+	blockPrintAble.Start = args.Start
+	blockPrintAble.TrxIds, err = getBlockTrxIds(&blockInfo, args.Start, args.Count)
+	if err != nil {
+		return err
+	}
+	*reply = blockPrintAble
+	return nil
+}
+
 func (s *Service) GetRawTrx(r *http.Request, args *string, reply *string) error {
 	var trxId bigint.Uint256
 	err := trxId.SetHex(*args)
 	if err != nil {
 		return err
 	}
-	bytesRawTrx, err := rawTrxDBMgr.DBGet(trxId)
+	bytesRawTrx, err := getRawTrxBytes(trxId)
 	if err != nil {
-		return errors.New("transaction id not found")
+		return newNotFoundError("transaction id")
 	}
 	*reply = hex.EncodeToString(bytesRawTrx)
 	return nil
 }
 
-func (s *Service) GetTrx(r *http.Request, args *string, reply *transaction.TrxPrintAble) error {
+func (s *Service) GetTrx(r *http.Request, args *string, reply *TrxPrintAble) error {
 	var trxId bigint.Uint256
 	err := trxId.SetHex(*args)
 	if err != nil {
 		return err
 	}
-	bytesRawTrx, err := rawTrxDBMgr.DBGet(trxId)
+	bytesRawTrx, err := getRawTrxBytes(trxId)
 	if err != nil {
-		return errors.New("transaction id not found")
+		return newNotFoundError("transaction id")
 	}
 	var trx transaction.Transaction
 	bytesBuf := bytes.NewBuffer(bytesRawTrx)
@@ -83,16 +135,37 @@ func (s *Service) GetTrx(r *http.Request, args *string, reply *transaction.TrxPr
 	if err != nil {
 		return errors.New("unpack raw transaction fail")
 	}
-	trxPrintAble := trx.GetTrxPrintAble()
+	var trxPrintAble TrxPrintAble
+	trxPrintAble.TrxPrintAble = trx.GetTrxPrintAble()
+	trxPrintAble.TrxId = trxId.GetHex()
+	trxPrintAble.Status, _ = getTrxStatusPrintAble(trxId)
 	*reply = trxPrintAble
 	return nil
 }
 
+func (s *Service) GetTrxVerbose(r *http.Request, args *string, reply *TrxVerbosePrintAble) error {
+	var trxId bigint.Uint256
+	err := trxId.SetHex(*args)
+	if err != nil {
+		return err
+	}
+	trx, err := getTrxByTrxId(trxId)
+	if err != nil {
+		return newNotFoundError("transaction id")
+	}
+	trxVerbose, err := getTrxVerbosePrintAble(trxId, trx)
+	if err != nil {
+		return err
+	}
+	*reply = trxVerbose
+	return nil
+}
+
 func (s *Service) GetUtxo(r *http.Request, args *UtxoSourcePrintAble, reply *UtxoDetailPrintAble) error {
 	utxoSource := args.GetUtxoSource()
 	utxoDetail, err := utxoDBMgr.DBGet(utxoSource)
 	if err != nil {
-		return errors.New("utxo source not found")
+		return newNotFoundError("utxo source")
 	}
 	utxoDetailPrintAble := utxoDetail.GetUtxoDetailPrintAble()
 	*reply = utxoDetailPrintAble
@@ -100,40 +173,195 @@ func (s *Service) GetUtxo(r *http.Request, args *UtxoSourcePrintAble, reply *Utx
 }
 
 func (s *Service) ListUnSpent(r *http.Request, args *string, reply *[]UtxoDetailPrintAble) error {
-	trxSeqs, err := addrTrxsDBMgr.DBGetPrefix(*args + ".")
+	trxSeqs, err := getAddressTrxSeqs(*args)
 	if err != nil {
-		return errors.New("address not found")
+		return newNotFoundError("address")
 	}
-	for _, trxSeq := range trxSeqs {
-		trxId, err := trxSeqDBMgr.DBGet(trxSeq)
-		if err != nil {
-			//return errors.New("trx sequence not found")
-			continue
-		}
-		bytesRawTrx, err := rawTrxDBMgr.DBGet(trxId)
-		if err != nil {
-			//return errors.New("transaction id not found")
-			continue
-		}
-		var trx transaction.Transaction
-		bytesBuf := bytes.NewBuffer(bytesRawTrx)
-		bytesReader := io.Reader(bytesBuf)
-		err = trx.UnPack(bytesReader)
-		if err != nil {
-			return errors.New("unpack raw transaction fail")
-		}
-		for i, _ := range trx.Vout {
-			var utxoSource UtxoSource
-			utxoSource.TrxId = trxId
-			utxoSource.Vout = uint32(i)
-			utxoDetail, err := utxoDBMgr.DBGet(utxoSource)
-			if err != nil {
-				continue
-			}
-			utxoDetailPrintAble := utxoDetail.GetUtxoDetailPrintAble()
-			*reply = append(*reply, utxoDetailPrintAble)
-		}
+	utxos, err := getAddressUtxos(*args, trxSeqs)
+	if err != nil {
+		return err
+	}
+	utxos = filterLockedUtxos(utxos)
+	for _, utxo := range utxos {
+		*reply = append(*reply, utxo.UtxoDetailPrintAble)
+	}
+	return nil
+}
+
+func (s *Service) GetAddressesTrxs(r *http.Request, args *[]string, reply *AddressesTrxsPrintAble) error {
+	addresses, err := uniqueAddresses(*args)
+	if err != nil {
+		return err
+	}
+	addressesTrxs, err := getAddressesTrxs(addresses)
+	if err != nil {
+		return err
+	}
+	*reply = addressesTrxs
+	return nil
+}
+
+func (s *Service) ListUnSpentMulti(r *http.Request, args *[]string, reply *[]AddressUtxosPrintAble) error {
+	addresses, err := uniqueAddresses(*args)
+	if err != nil {
+		return err
+	}
+	addressesUtxos, err := listUnSpentMulti(addresses)
+	if err != nil {
+		return err
+	}
+	for i := range addressesUtxos {
+		addressesUtxos[i].Utxos = filterLockedUtxos(addressesUtxos[i].Utxos)
+	}
+	*reply = addressesUtxos
+	return nil
+}
+
+func (s *Service) GetAddressesBalance(r *http.Request, args *[]string, reply *AddressesBalancePrintAble) error {
+	addresses, err := uniqueAddresses(*args)
+	if err != nil {
+		return err
+	}
+	addressesBalance, err := getAddressesBalance(addresses)
+	if err != nil {
+		return err
+	}
+	*reply = addressesBalance
+	return nil
+}
+
+func (s *Service) ScanXpub(r *http.Request, args *XpubScanArgs, reply *XpubScanPrintAble) error {
+	xpubScan, err := scanXpub(args)
+	if err != nil {
+		return err
 	}
+	*reply = xpubScan
+	return nil
+}
+
+func (s *Service) ScanDescriptor(r *http.Request, args *DescriptorScanArgs, reply *DescriptorScanPrintAble) error {
+	descScan, err := scanDescriptor(args)
+	if err != nil {
+		return err
+	}
+	*reply = descScan
+	return nil
+}
+
+func (s *Service) SendRawTrx(r *http.Request, args *string, reply *SendRawTrxPrintAble) error {
+	*reply = sendRawTrx(*args)
+	return nil
+}
+
+func (s *Service) EstimateFee(r *http.Request, args *uint32, reply *FeeEstimatePrintAble) error {
+	estimate, err := estimateFee(*args)
+	if err != nil {
+		return err
+	}
+	*reply = estimate
+	return nil
+}
+
+func (s *Service) GetBlockFeeStats(r *http.Request, args *uint32, reply *BlockFeeStatsPrintAble) error {
+	feeStats, err := getBlockFeeStats(*args)
+	if err != nil {
+		return err
+	}
+	*reply = feeStats
+	return nil
+}
+
+func (s *Service) CreatePsbt(r *http.Request, args *CreatePsbtArgs, reply *CreatePsbtPrintAble) error {
+	psbt, err := createPsbt(args)
+	if err != nil {
+		return err
+	}
+	*reply = psbt
+	return nil
+}
+
+func (s *Service) LockUnspent(r *http.Request, args *LockUnspentArgs, reply *LockUnspentPrintAble) error {
+	lockUnspentPrintAble, err := lockUnspent(args)
+	if err != nil {
+		return err
+	}
+	*reply = lockUnspentPrintAble
+	return nil
+}
+
+func (s *Service) UnlockUnspent(r *http.Request, args *UnlockUnspentArgs, reply *uint32) error {
+	count, err := unlockUnspent(args)
+	if err != nil {
+		return err
+	}
+	*reply = count
+	return nil
+}
+
+func (s *Service) ListLocked(r *http.Request, args *interface{}, reply *[]UtxoLockPrintAble) error {
+	utxoLocks, err := listLocked()
+	if err != nil {
+		return err
+	}
+	*reply = utxoLocks
+	return nil
+}
+
+func (s *Service) GetUtxoSetInfo(r *http.Request, args *interface{}, reply *UtxoSetInfoPrintAble) error {
+	flushMutex.Lock()
+	defer flushMutex.Unlock()
+	blockHeight, err := getStartBlockHeight()
+	if err != nil {
+		return err
+	}
+	utxoSetInfoPrintAble := utxoSetInfo.GetUtxoSetInfoPrintAble()
+	utxoSetInfoPrintAble.BlockHeight = blockHeight
+	*reply = utxoSetInfoPrintAble
+	return nil
+}
+
+func (s *Service) BackupDB(r *http.Request, args *string, reply *BackupManifest) error {
+	manifest, err := backupAllDB(*args)
+	if err != nil {
+		return err
+	}
+	*reply = manifest
+	return nil
+}
+
+func (s *Service) VerifyIndex(r *http.Request, args *VerifyArgs, reply *VerifyReport) error {
+	report, err := verifyIndexDB(args)
+	if err != nil {
+		return err
+	}
+	*reply = report
+	return nil
+}
+
+func (s *Service) GetAddressTxIds(r *http.Request, args *AddressIndexArgs, reply *[]string) error {
+	trxIds, err := getAddressIndexTrxIds(args)
+	if err != nil {
+		return err
+	}
+	*reply = trxIds
+	return nil
+}
+
+func (s *Service) GetAddressUtxos(r *http.Request, args *AddressIndexArgs, reply *[]AddressIndexUtxoPrintAble) error {
+	utxos, err := getAddressIndexUtxos(args)
+	if err != nil {
+		return err
+	}
+	*reply = utxos
+	return nil
+}
+
+func (s *Service) GetAddressBalance(r *http.Request, args *AddressIndexArgs, reply *AddressIndexBalancePrintAble) error {
+	balance, err := getAddressIndexBalance(args)
+	if err != nil {
+		return err
+	}
+	*reply = balance
 	return nil
 }
 
@@ -145,9 +373,20 @@ func rpcServer(goroutine goroutine_mgr.Goroutine, args ...interface{}) {
 
 	rpcService := new(Service)
 	_ = rpcServer.RegisterService(rpcService, "")
+	registerRpcMetricsFuncs(rpcServer)
 
 	urlRouter := mux.NewRouter()
-	urlRouter.Handle("/", rpcServer)
+	urlRouter.Handle("/", newJsonRpc2Handler(rpcService, rpcServer))
+	registerRestHandlers(urlRouter)
+	registerEsploraHandlers(urlRouter)
+	registerInsightHandlers(urlRouter)
+	urlRouter.HandleFunc("/metrics", handleMetrics).Methods("GET")
+	urlRouter.Use(authHandler, rateLimitHandler)
+	if config.RpcServerConfig.TlsCertFile != "" {
+		_ = http.ListenAndServeTLS(config.RpcServerConfig.RpcListenEndPoint,
+			config.RpcServerConfig.TlsCertFile, config.RpcServerConfig.TlsKeyFile, urlRouter)
+		return
+	}
 	_ = http.ListenAndServe(config.RpcServerConfig.RpcListenEndPoint, urlRouter)
 }
 
@@ -0,0 +1,443 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"github.com/mutalisk999/bitcoin-lib/src/serialize"
+	"io"
+	"os"
+	"strconv"
+)
+
+const (
+	SnapshotMagic   = "SPVSNAP"
+	SnapshotVersion = 1
+)
+
+type snapshotHeader struct {
+	blockHeight  uint32
+	trxSequence  uint32
+	undoHeight   uint32
+	indexVersion uint32
+	network      string
+}
+
+type snapshotSection struct {
+	name string
+	db   *DBCommon
+}
+
+func getSnapshotSections() []snapshotSection {
+	return []snapshotSection{
+		{"utxo_db", utxoDBMgr.db},
+		{"addr_trx_db", addrTrxsDBMgr.db},
+		{"trx_seq_db", trxSeqDBMgr.db},
+		{"trx_loc_db", trxLocDBMgr.db},
+		{"block_db", blockDBMgr.db},
+		{"block_hash_db", blockHashDBMgr.db},
+		{"raw_trx_db", rawTrxDBMgr.db},
+		{"trx_undo_db", trxUndoDBMgr.db},
+	}
+}
+
+// getTrxUndoHeight returns the height above which the trx undo data is complete,
+// it is the height of the imported snapshot without the undo data
+func getTrxUndoHeight() (uint32, error) {
+	undoHeightStr, err := globalConfigDBMgr.DBGet("trxUndoHeight")
+	if err != nil {
+		if err.Error() == NotFoundError {
+			return 0, nil
+		}
+		return 0, err
+	}
+	ui64, err := strconv.ParseUint(undoHeightStr, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(ui64), nil
+}
+
+func storeTrxUndoHeight(undoHeight uint32) error {
+	err := globalConfigDBMgr.DBPut("trxUndoHeight", strconv.Itoa(int(undoHeight)))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func packSnapshotBytes(writer io.Writer, data []byte) error {
+	err := serialize.PackCompactSize(writer, uint64(len(data)))
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func unpackSnapshotBytes(reader io.Reader) ([]byte, error) {
+	ui64, err := serialize.UnPackCompactSize(reader)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, ui64)
+	_, err = io.ReadFull(reader, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func writeSnapshot(file *os.File, header snapshotHeader) error {
+	fileWriter := bufio.NewWriter(file)
+	checkSum := sha256.New()
+	writer := io.MultiWriter(fileWriter, checkSum)
+
+	// header
+	_, err := writer.Write([]byte(SnapshotMagic))
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint32(writer, SnapshotVersion)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint32(writer, header.blockHeight)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint32(writer, header.trxSequence)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint32(writer, header.undoHeight)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint32(writer, header.indexVersion)
+	if err != nil {
+		return err
+	}
+	err = packSnapshotBytes(writer, []byte(header.network))
+	if err != nil {
+		return err
+	}
+
+	// sections, every section is ended with an empty key
+	sections := getSnapshotSections()
+	err = serialize.PackCompactSize(writer, uint64(len(sections)))
+	if err != nil {
+		return err
+	}
+	for _, section := range sections {
+		err = packSnapshotBytes(writer, []byte(section.name))
+		if err != nil {
+			return err
+		}
+		err = section.db.DBIterate([]byte{}, func(k []byte, v []byte) error {
+			err := packSnapshotBytes(writer, k)
+			if err != nil {
+				return err
+			}
+			return packSnapshotBytes(writer, v)
+		})
+		if err != nil {
+			return err
+		}
+		err = packSnapshotBytes(writer, []byte{})
+		if err != nil {
+			return err
+		}
+	}
+
+	// checksum of all the data above
+	_, err = fileWriter.Write(checkSum.Sum(nil))
+	if err != nil {
+		return err
+	}
+	err = fileWriter.Flush()
+	if err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+func exportSnapshot(fileName string) (uint32, error) {
+	// hold the flush mutex, so that the dbs stay at the committed height while exporting
+	flushMutex.Lock()
+	defer flushMutex.Unlock()
+
+	var header snapshotHeader
+	var err error
+	header.blockHeight, err = getStartBlockHeight()
+	if err != nil {
+		return 0, err
+	}
+	header.trxSequence, err = getStartTrxSequence()
+	if err != nil {
+		return 0, err
+	}
+	header.undoHeight, err = getTrxUndoHeight()
+	if err != nil {
+		return 0, err
+	}
+	header.indexVersion, err = getIndexVersion()
+	if err != nil {
+		return 0, err
+	}
+	header.network, err = getIndexNetwork()
+	if err != nil {
+		return 0, err
+	}
+	if header.network == "" {
+		header.network = networkParams.Name
+	}
+
+	// write to a temp file, so that a failed export leaves no partial snapshot behind
+	tmpFileName := fileName + ".tmp"
+	file, err := os.Create(tmpFileName)
+	if err != nil {
+		return 0, err
+	}
+	err = writeSnapshot(file, header)
+	errClose := file.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err == nil {
+		err = os.Rename(tmpFileName, fileName)
+	}
+	if err != nil {
+		_ = os.Remove(tmpFileName)
+		return 0, err
+	}
+	return header.blockHeight, nil
+}
+
+func verifySnapshotCheckSum(fileName string) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if fileInfo.Size() < int64(len(SnapshotMagic)+21+sha256.Size) {
+		return errors.New("invalid snapshot file size")
+	}
+	checkSum := sha256.New()
+	_, err = io.CopyN(checkSum, file, fileInfo.Size()-sha256.Size)
+	if err != nil {
+		return err
+	}
+	checkSumExpect := make([]byte, sha256.Size)
+	_, err = io.ReadFull(file, checkSumExpect)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(checkSum.Sum(nil), checkSumExpect) {
+		return errors.New("snapshot checksum mismatch")
+	}
+	return nil
+}
+
+func isIndexDBEmpty() (bool, error) {
+	_, err := globalConfigDBMgr.DBGet("blockHeight")
+	if err == nil {
+		return false, nil
+	}
+	if err.Error() != NotFoundError {
+		return false, err
+	}
+	isEmpty := true
+	for _, section := range getSnapshotSections() {
+		err = section.db.DBIterate([]byte{}, func(k []byte, v []byte) error {
+			isEmpty = false
+			return errors.New("db is not empty")
+		})
+		if !isEmpty {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// clearSnapshotSections removes what a failed import has written, so that the import can be retried
+func clearSnapshotSections() error {
+	for _, section := range getSnapshotSections() {
+		var keys [][]byte
+		err := section.db.DBIterate([]byte{}, func(k []byte, v []byte) error {
+			keys = append(keys, k)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			err = section.db.DBDelete(k)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readSnapshot writes the sections into the dbs, returns the header
+func readSnapshot(fileName string) (snapshotHeader, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return snapshotHeader{}, err
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+
+	// header
+	magic := make([]byte, len(SnapshotMagic))
+	_, err = io.ReadFull(reader, magic)
+	if err != nil {
+		return snapshotHeader{}, err
+	}
+	if string(magic) != SnapshotMagic {
+		return snapshotHeader{}, errors.New("invalid snapshot magic")
+	}
+	version, err := serialize.UnPackUint32(reader)
+	if err != nil {
+		return snapshotHeader{}, err
+	}
+	if version != SnapshotVersion {
+		return snapshotHeader{}, errors.New("unsupported snapshot version: " + strconv.Itoa(int(version)))
+	}
+	var header snapshotHeader
+	header.blockHeight, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return snapshotHeader{}, err
+	}
+	header.trxSequence, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return snapshotHeader{}, err
+	}
+	header.undoHeight, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return snapshotHeader{}, err
+	}
+	header.indexVersion, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return snapshotHeader{}, err
+	}
+	network, err := unpackSnapshotBytes(reader)
+	if err != nil {
+		return snapshotHeader{}, err
+	}
+	header.network = string(network)
+	// the addresses of the snapshot are encoded for its network
+	if header.network != networkParams.Name {
+		return snapshotHeader{}, errors.New("the snapshot is indexed for network " + header.network + ", not " + networkParams.Name)
+	}
+
+	// sections
+	sectionsMap := make(map[string]*DBCommon)
+	for _, section := range getSnapshotSections() {
+		sectionsMap[section.name] = section.db
+	}
+	sectionCount, err := serialize.UnPackCompactSize(reader)
+	if err != nil {
+		return snapshotHeader{}, err
+	}
+	for i := 0; i < int(sectionCount); i++ {
+		sectionName, err := unpackSnapshotBytes(reader)
+		if err != nil {
+			return snapshotHeader{}, err
+		}
+		db, ok := sectionsMap[string(sectionName)]
+		if !ok {
+			return snapshotHeader{}, errors.New("unknown snapshot section: " + string(sectionName))
+		}
+		for {
+			k, err := unpackSnapshotBytes(reader)
+			if err != nil {
+				return snapshotHeader{}, err
+			}
+			if len(k) == 0 {
+				break
+			}
+			v, err := unpackSnapshotBytes(reader)
+			if err != nil {
+				return snapshotHeader{}, err
+			}
+			err = db.DBPut(k, v)
+			if err != nil {
+				return snapshotHeader{}, err
+			}
+		}
+		fmt.Println("snapshot section imported:", string(sectionName))
+	}
+	return header, nil
+}
+
+func importSnapshot(fileName string) (uint32, error) {
+	isEmpty, err := isIndexDBEmpty()
+	if err != nil {
+		return 0, err
+	}
+	if !isEmpty {
+		return 0, errors.New("db dir is not empty, can not import snapshot")
+	}
+	err = verifySnapshotCheckSum(fileName)
+	if err != nil {
+		return 0, err
+	}
+	header, err := readSnapshot(fileName)
+	if err != nil {
+		errClear := clearSnapshotSections()
+		if errClear != nil {
+			fmt.Println("clear the imported snapshot sections failed:", errClear)
+		}
+		return 0, err
+	}
+
+	// the dbs are written, commit the height of the snapshot
+	err = storeTrxUndoHeight(header.undoHeight)
+	if err != nil {
+		return 0, err
+	}
+	err = storeIndexVersion(header.indexVersion)
+	if err != nil {
+		return 0, err
+	}
+	err = storeIndexNetwork(header.network)
+	if err != nil {
+		return 0, err
+	}
+	err = storeStartBlockHeight(header.blockHeight)
+	if err != nil {
+		return 0, err
+	}
+	err = storeStartTrxSequence(header.trxSequence)
+	if err != nil {
+		return 0, err
+	}
+	err = storeChainIndexState("1")
+	if err != nil {
+		return 0, err
+	}
+	utxoSetInfo, err = rebuildUtxoSetInfo()
+	if err != nil {
+		return 0, err
+	}
+	err = rebuildScriptHashIndex()
+	if err != nil {
+		return 0, err
+	}
+	return header.blockHeight, nil
+}
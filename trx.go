@@ -0,0 +1,294 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/bigint"
+	"github.com/mutalisk999/bitcoin-lib/src/block"
+	"github.com/mutalisk999/bitcoin-lib/src/script"
+	"github.com/mutalisk999/bitcoin-lib/src/transaction"
+	"io"
+	"strconv"
+)
+
+type TxInVerbosePrintAble struct {
+	PrevOut       transaction.OutPointPrintAble
+	ScriptSig     string
+	Sequence      uint32
+	ScriptWitness []string
+	Value         int64
+	Address       string
+	ScriptPubKey  string
+	ScriptType    string
+}
+
+type TrxStatusPrintAble struct {
+	TrxId         string
+	TrxSeq        uint32
+	BlockHeight   uint32
+	BlockIndex    uint32
+	BlockHash     string
+	Confirmations uint32
+}
+
+type TrxPrintAble struct {
+	transaction.TrxPrintAble
+	TrxId  string
+	Status TrxStatusPrintAble
+}
+
+type TrxVerbosePrintAble struct {
+	TrxId    string
+	Status   TrxStatusPrintAble
+	Vin      []TxInVerbosePrintAble
+	Vout     []transaction.TxOutPrintAble
+	Version  int32
+	LockTime uint32
+	Size     int
+	VSize    int
+	Weight   int
+	Fee      int64
+	FeeRate  float64
+}
+
+func isCoinBaseTrx(trx *transaction.Transaction) bool {
+	if len(trx.Vin) != 1 {
+		return false
+	}
+	return trx.Vin[0].PrevOut.Hash.GetHex() == "0000000000000000000000000000000000000000000000000000000000000000"
+}
+
+func calcTrxSize(trx *transaction.Transaction) (int, int, int, error) {
+	bytesBuf := bytes.NewBuffer([]byte{})
+	err := trx.Pack(io.Writer(bytesBuf))
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	totalSize := bytesBuf.Len()
+
+	bytesBuf = bytes.NewBuffer([]byte{})
+	err = trx.PackNoWitness(io.Writer(bytesBuf))
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	baseSize := bytesBuf.Len()
+
+	// weight = base size * 3 + total size, vsize = weight / 4 rounded up
+	weight := baseSize*3 + totalSize
+	vsize := (weight + 3) / 4
+	return totalSize, vsize, weight, nil
+}
+
+func getTrxStatusPrintAble(trxId bigint.Uint256) (TrxStatusPrintAble, error) {
+	trxLocation, err := trxLocDBMgr.DBGet(trxId)
+	if err != nil {
+		return TrxStatusPrintAble{}, err
+	}
+	var trxStatus TrxStatusPrintAble
+	trxStatus.TrxId = trxId.GetHex()
+	trxStatus.TrxSeq = trxLocation.TrxSeq
+	trxStatus.BlockHeight = trxLocation.BlockHeight
+	trxStatus.BlockIndex = trxLocation.BlockIndex
+	trxStatus.BlockHash = trxLocation.BlockHash.GetHex()
+	blockHeight := getFlushedBlockHeight()
+	if blockHeight >= trxLocation.BlockHeight {
+		trxStatus.Confirmations = blockHeight - trxLocation.BlockHeight + 1
+	}
+	return trxStatus, nil
+}
+
+func getTrxStatusBySeq(trxSeq uint32) (TrxStatusPrintAble, error) {
+	trxId, err := trxSeqDBMgr.DBGet(trxSeq)
+	if err != nil {
+		return TrxStatusPrintAble{}, err
+	}
+	trxStatus, err := getTrxStatusPrintAble(trxId)
+	if err != nil {
+		// the trx is indexed without location
+		trxStatus.TrxId = trxId.GetHex()
+		trxStatus.TrxSeq = trxSeq
+	}
+	return trxStatus, nil
+}
+
+func checkRawTrx(trxId bigint.Uint256, bytesRawTrx []byte) error {
+	var trx transaction.Transaction
+	err := trx.UnPack(io.Reader(bytes.NewBuffer(bytesRawTrx)))
+	if err != nil {
+		return err
+	}
+	trxIdCalc, err := trx.CalcTrxId()
+	if err != nil {
+		return err
+	}
+	if !bigint.IsUint256Equal(&trxIdCalc, &trxId) {
+		return errors.New("trx id mismatch")
+	}
+	return nil
+}
+
+func getRawTrxFromBlock(trxLocation *TrxLocation) ([]byte, error) {
+	rawBlockData, err := getRawBlockByHash(trxLocation.BlockHash.GetHex())
+	if err != nil {
+		return nil, err
+	}
+	blockBytes, err := hex.DecodeString(rawBlockData)
+	if err != nil {
+		return nil, err
+	}
+	var blockNew block.Block
+	err = blockNew.UnPack(io.Reader(bytes.NewBuffer(blockBytes)))
+	if err != nil {
+		return nil, err
+	}
+	if int(trxLocation.BlockIndex) >= len(blockNew.Vtx) {
+		return nil, errors.New("trx index out of block")
+	}
+	bytesBuf := bytes.NewBuffer([]byte{})
+	err = blockNew.Vtx[trxLocation.BlockIndex].Pack(io.Writer(bytesBuf))
+	if err != nil {
+		return nil, err
+	}
+	return bytesBuf.Bytes(), nil
+}
+
+func fetchRawTrxFromSource(trxId bigint.Uint256) ([]byte, error) {
+	trxLocation, err := trxLocDBMgr.DBGet(trxId)
+	if err != nil {
+		return nil, err
+	}
+	if config.RpcClientConfig.DataSource == "btcWallet" {
+		// the wallet node can find the trx in the block without txindex
+		rawTrxHex, err := getRawTrxType1(trxId.GetHex(), trxLocation.BlockHash.GetHex())
+		if err == nil {
+			bytesRawTrx, err := hex.DecodeString(rawTrxHex)
+			if err == nil && checkRawTrx(trxId, bytesRawTrx) == nil {
+				return bytesRawTrx, nil
+			}
+		}
+	}
+	bytesRawTrx, err := getRawTrxFromBlock(&trxLocation)
+	if err != nil {
+		return nil, err
+	}
+	err = checkRawTrx(trxId, bytesRawTrx)
+	if err != nil {
+		return nil, err
+	}
+	return bytesRawTrx, nil
+}
+
+func getRawTrxBytes(trxId bigint.Uint256) ([]byte, error) {
+	bytesRawTrx, err := rawTrxDBMgr.DBGet(trxId)
+	if err == nil {
+		return bytesRawTrx, nil
+	}
+	// the raw trx is not stored or pruned, fetch from the data source
+	trxIdStr := string(trxId.GetData())
+	bytesRawTrx, ok := rawTrxCache.Get(trxIdStr)
+	if ok {
+		return bytesRawTrx, nil
+	}
+	bytesRawTrx, err = fetchRawTrxFromSource(trxId)
+	if err != nil {
+		return nil, err
+	}
+	rawTrxCache.Add(trxIdStr, bytesRawTrx)
+	return bytesRawTrx, nil
+}
+
+func getTrxByTrxId(trxId bigint.Uint256) (*transaction.Transaction, error) {
+	bytesRawTrx, err := getRawTrxBytes(trxId)
+	if err != nil {
+		return nil, err
+	}
+	trx := new(transaction.Transaction)
+	err = trx.UnPack(io.Reader(bytes.NewBuffer(bytesRawTrx)))
+	if err != nil {
+		return nil, err
+	}
+	return trx, nil
+}
+
+func getTrxPrevOuts(trxId bigint.Uint256, trx *transaction.Transaction) ([]UtxoDetail, error) {
+	// resolve from trx undo db first
+	spentUtxos, err := trxUndoDBMgr.DBGet(trxId)
+	if err == nil && len(spentUtxos) == len(trx.Vin) {
+		prevOuts := make([]UtxoDetail, len(spentUtxos), len(spentUtxos))
+		for i, spentUtxo := range spentUtxos {
+			prevOuts[i] = spentUtxo.UtxoDetail
+		}
+		return prevOuts, nil
+	}
+
+	// the trx is indexed without undo data, resolve from the raw trx of each prevout
+	prevOuts := make([]UtxoDetail, len(trx.Vin), len(trx.Vin))
+	for i, vin := range trx.Vin {
+		prevTrx, err := getTrxByTrxId(vin.PrevOut.Hash)
+		if err != nil || int(vin.PrevOut.N) >= len(prevTrx.Vout) {
+			return nil, errors.New("can not resolve prevout trxid: " + vin.PrevOut.Hash.GetHex() + ", vout: " + strconv.Itoa(int(vin.PrevOut.N)))
+		}
+		prevOut := prevTrx.Vout[vin.PrevOut.N]
+		prevOuts[i].Amount = prevOut.Value
+		prevOuts[i].ScriptPubKey = prevOut.ScriptPubKey
+		prevOuts[i].Address = getAddressFromScript(prevOut.ScriptPubKey)
+	}
+	return prevOuts, nil
+}
+
+func getTrxVerbosePrintAble(trxId bigint.Uint256, trx *transaction.Transaction) (TrxVerbosePrintAble, error) {
+	var trxVerbose TrxVerbosePrintAble
+	trxPrintAble := trx.GetTrxPrintAble()
+	trxVerbose.TrxId = trxId.GetHex()
+	trxVerbose.Status, _ = getTrxStatusPrintAble(trxId)
+	trxVerbose.Vout = trxPrintAble.Vout
+	trxVerbose.Version = trxPrintAble.Version
+	trxVerbose.LockTime = trxPrintAble.LockTime
+
+	var err error
+	trxVerbose.Size, trxVerbose.VSize, trxVerbose.Weight, err = calcTrxSize(trx)
+	if err != nil {
+		return TrxVerbosePrintAble{}, err
+	}
+
+	isCoinBase := isCoinBaseTrx(trx)
+	var prevOuts []UtxoDetail
+	if !isCoinBase {
+		prevOuts, err = getTrxPrevOuts(trxId, trx)
+		if err != nil {
+			return TrxVerbosePrintAble{}, err
+		}
+	}
+
+	var valueIn int64 = 0
+	var valueOut int64 = 0
+	trxVerbose.Vin = make([]TxInVerbosePrintAble, len(trxPrintAble.Vin), len(trxPrintAble.Vin))
+	for i, vinPrintAble := range trxPrintAble.Vin {
+		var vinVerbose TxInVerbosePrintAble
+		vinVerbose.PrevOut = vinPrintAble.PrevOut
+		vinVerbose.ScriptSig = vinPrintAble.ScriptSig
+		vinVerbose.Sequence = vinPrintAble.Sequence
+		vinVerbose.ScriptWitness = vinPrintAble.ScriptWitness
+		if !isCoinBase {
+			vinVerbose.Value = prevOuts[i].Amount
+			vinVerbose.Address = prevOuts[i].Address
+			vinVerbose.ScriptPubKey = hex.EncodeToString(prevOuts[i].ScriptPubKey.GetScriptBytes())
+			_, scriptType, _ := script.ExtractDestination(prevOuts[i].ScriptPubKey)
+			vinVerbose.ScriptType = script.GetScriptTypeStr(scriptType)
+			valueIn += prevOuts[i].Amount
+		}
+		trxVerbose.Vin[i] = vinVerbose
+	}
+	for _, vout := range trx.Vout {
+		valueOut += vout.Value
+	}
+
+	if !isCoinBase {
+		trxVerbose.Fee = valueIn - valueOut
+		if trxVerbose.VSize > 0 {
+			trxVerbose.FeeRate = float64(trxVerbose.Fee) / float64(trxVerbose.VSize)
+		}
+	}
+	return trxVerbose, nil
+}
@@ -163,3 +163,92 @@ func (u *UtxoDetailPrintAble) GetUtxoDetail() (UtxoDetail, error) {
 	utxoDetail.ScriptPubKey.SetScriptBytes(bytesScript)
 	return utxoDetail, nil
 }
+
+type SpentUtxo struct {
+	UtxoSource UtxoSource
+	UtxoDetail UtxoDetail
+}
+
+func (s SpentUtxo) Pack(writer io.Writer) error {
+	err := s.UtxoSource.Pack(writer)
+	if err != nil {
+		return err
+	}
+	err = s.UtxoDetail.Pack(writer)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *SpentUtxo) UnPack(reader io.Reader) error {
+	err := s.UtxoSource.UnPack(reader)
+	if err != nil {
+		return err
+	}
+	err = s.UtxoDetail.UnPack(reader)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type TrxLocation struct {
+	BlockHeight uint32
+	BlockIndex  uint32
+	TrxSeq      uint32
+	BlockHash   bigint.Uint256
+}
+
+func (t TrxLocation) Pack(writer io.Writer) error {
+	err := serialize.PackUint32(writer, t.BlockHeight)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint32(writer, t.BlockIndex)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackUint32(writer, t.TrxSeq)
+	if err != nil {
+		return err
+	}
+	err = t.BlockHash.Pack(writer)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *TrxLocation) UnPack(reader io.Reader) error {
+	var err error
+	t.BlockHeight, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return err
+	}
+	t.BlockIndex, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return err
+	}
+	t.TrxSeq, err = serialize.UnPackUint32(reader)
+	if err != nil {
+		return err
+	}
+	err = t.BlockHash.UnPack(reader)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type UtxoPrintAble struct {
+	UtxoSourcePrintAble
+	UtxoDetailPrintAble
+}
+
+func GetUtxoPrintAble(utxoSrc *UtxoSource, utxoDetail *UtxoDetail) UtxoPrintAble {
+	var utxoPrintAble UtxoPrintAble
+	utxoPrintAble.UtxoSourcePrintAble = utxoSrc.GetUtxoSourcePrintAble()
+	utxoPrintAble.UtxoDetailPrintAble = utxoDetail.GetUtxoDetailPrintAble()
+	return utxoPrintAble
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/blob"
+	"github.com/mutalisk999/bitcoin-lib/src/serialize"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	UtxoLockTTLDefault = 300
+	UtxoLockTTLMax     = 86400
+	UtxoLockCountMax   = 1000
+)
+
+// UtxoLock is owned by the caller holding the owner token, only the sha256 of the token is stored
+type UtxoLock struct {
+	Label      string
+	LockTime   int64
+	ExpireTime int64
+	OwnerHash  string
+}
+
+func (u UtxoLock) Pack(writer io.Writer) error {
+	var bytesLabel blob.Byteblob
+	bytesLabel.SetData([]byte(u.Label))
+	err := bytesLabel.Pack(writer)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackInt64(writer, u.LockTime)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackInt64(writer, u.ExpireTime)
+	if err != nil {
+		return err
+	}
+	var bytesOwnerHash blob.Byteblob
+	bytesOwnerHash.SetData([]byte(u.OwnerHash))
+	err = bytesOwnerHash.Pack(writer)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *UtxoLock) UnPack(reader io.Reader) error {
+	var bytesLabel blob.Byteblob
+	err := bytesLabel.UnPack(reader)
+	if err != nil {
+		return err
+	}
+	u.Label = string(bytesLabel.GetData())
+	u.LockTime, err = serialize.UnPackInt64(reader)
+	if err != nil {
+		return err
+	}
+	u.ExpireTime, err = serialize.UnPackInt64(reader)
+	if err != nil {
+		return err
+	}
+	// the locks of the older version have no owner, they are kept until expired
+	var bytesOwnerHash blob.Byteblob
+	err = bytesOwnerHash.UnPack(reader)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	u.OwnerHash = string(bytesOwnerHash.GetData())
+	return nil
+}
+
+func (u UtxoLock) isExpired(now int64) bool {
+	return u.ExpireTime <= now
+}
+
+type LockUnspentArgs struct {
+	Utxos []UtxoSourcePrintAble
+	TTL   uint32
+	Label string
+	// the owner token of the locks to renew, a new token is returned if it is empty
+	Owner string
+}
+
+type UnlockUnspentArgs struct {
+	Utxos []UtxoSourcePrintAble
+	Owner string
+}
+
+type UtxoLockPrintAble struct {
+	UtxoSourcePrintAble
+	Label      string
+	LockTime   int64
+	ExpireTime int64
+}
+
+func GetUtxoLockPrintAble(utxoSrc *UtxoSource, utxoLock *UtxoLock) UtxoLockPrintAble {
+	var utxoLockPrintAble UtxoLockPrintAble
+	utxoLockPrintAble.UtxoSourcePrintAble = utxoSrc.GetUtxoSourcePrintAble()
+	utxoLockPrintAble.Label = utxoLock.Label
+	utxoLockPrintAble.LockTime = utxoLock.LockTime
+	utxoLockPrintAble.ExpireTime = utxoLock.ExpireTime
+	return utxoLockPrintAble
+}
+
+type LockUnspentPrintAble struct {
+	Owner string
+	Locks []UtxoLockPrintAble
+}
+
+func newUtxoLockOwner() (string, error) {
+	owner := make([]byte, 16)
+	_, err := rand.Read(owner)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(owner), nil
+}
+
+func calcUtxoLockOwnerHash(owner string) string {
+	ownerHash := sha256.Sum256([]byte(owner))
+	return hex.EncodeToString(ownerHash[0:])
+}
+
+// utxoLockMutex makes the check and the lock of utxos atomic between callers
+var utxoLockMutex = new(sync.Mutex)
+
+func getUtxoSources(utxos []UtxoSourcePrintAble) ([]UtxoSource, error) {
+	if len(utxos) > UtxoLockCountMax {
+		return nil, errors.New("too many utxos, max: " + strconv.Itoa(UtxoLockCountMax))
+	}
+	utxoSrcs := make([]UtxoSource, 0, len(utxos))
+	for _, utxo := range utxos {
+		var utxoSrc UtxoSource
+		err := utxoSrc.TrxId.SetHex(utxo.TrxId)
+		if err != nil {
+			return nil, err
+		}
+		utxoSrc.Vout = utxo.Vout
+		utxoSrcs = append(utxoSrcs, utxoSrc)
+	}
+	return utxoSrcs, nil
+}
+
+// lockUnspent locks all the utxos or none of them, a lock can be renewed only with its owner token
+func lockUnspent(args *LockUnspentArgs) (LockUnspentPrintAble, error) {
+	utxoSrcs, err := getUtxoSources(args.Utxos)
+	if err != nil {
+		return LockUnspentPrintAble{}, err
+	}
+	if len(utxoSrcs) == 0 {
+		return LockUnspentPrintAble{}, errors.New("utxos is empty")
+	}
+	ttl := args.TTL
+	if ttl == 0 {
+		ttl = UtxoLockTTLDefault
+	}
+	if ttl > UtxoLockTTLMax {
+		return LockUnspentPrintAble{}, errors.New("ttl is too large, max: " + strconv.Itoa(UtxoLockTTLMax))
+	}
+	owner := args.Owner
+	if owner == "" {
+		owner, err = newUtxoLockOwner()
+		if err != nil {
+			return LockUnspentPrintAble{}, err
+		}
+	}
+	ownerHash := calcUtxoLockOwnerHash(owner)
+
+	utxoLockMutex.Lock()
+	defer utxoLockMutex.Unlock()
+	now := time.Now().Unix()
+	for _, utxoSrc := range utxoSrcs {
+		_, ok := getUnspentUtxo(utxoSrc)
+		if !ok {
+			return LockUnspentPrintAble{}, errors.New("utxo " + utxoSrc.TrxId.GetHex() + ":" + strconv.Itoa(int(utxoSrc.Vout)) + " not found or spent")
+		}
+		utxoLock, err := utxoLockDBMgr.DBGet(utxoSrc)
+		if err == nil && !utxoLock.isExpired(now) && utxoLock.OwnerHash != ownerHash {
+			return LockUnspentPrintAble{}, errors.New("utxo " + utxoSrc.TrxId.GetHex() + ":" + strconv.Itoa(int(utxoSrc.Vout)) + " is locked")
+		}
+	}
+	utxoLock := UtxoLock{args.Label, now, now + int64(ttl), ownerHash}
+	lockUnspentPrintAble := LockUnspentPrintAble{owner, make([]UtxoLockPrintAble, 0, len(utxoSrcs))}
+	for i := range utxoSrcs {
+		err = utxoLockDBMgr.DBPut(utxoSrcs[i], utxoLock)
+		if err != nil {
+			return LockUnspentPrintAble{}, err
+		}
+		lockUnspentPrintAble.Locks = append(lockUnspentPrintAble.Locks, GetUtxoLockPrintAble(&utxoSrcs[i], &utxoLock))
+	}
+	return lockUnspentPrintAble, nil
+}
+
+// unlockUnspent unlocks the utxos locked by the owner, none is unlocked if any is locked by another owner
+func unlockUnspent(args *UnlockUnspentArgs) (uint32, error) {
+	utxoSrcs, err := getUtxoSources(args.Utxos)
+	if err != nil {
+		return 0, err
+	}
+	if len(utxoSrcs) == 0 {
+		return 0, errors.New("utxos is empty")
+	}
+	if args.Owner == "" {
+		return 0, errors.New("owner is empty")
+	}
+	ownerHash := calcUtxoLockOwnerHash(args.Owner)
+	utxoLockMutex.Lock()
+	defer utxoLockMutex.Unlock()
+	now := time.Now().Unix()
+	var utxoSrcsOwned []UtxoSource
+	for _, utxoSrc := range utxoSrcs {
+		utxoLock, err := utxoLockDBMgr.DBGet(utxoSrc)
+		if err != nil || utxoLock.isExpired(now) {
+			continue
+		}
+		if utxoLock.OwnerHash != ownerHash {
+			return 0, errors.New("utxo " + utxoSrc.TrxId.GetHex() + ":" + strconv.Itoa(int(utxoSrc.Vout)) + " is locked by another owner")
+		}
+		utxoSrcsOwned = append(utxoSrcsOwned, utxoSrc)
+	}
+	var count uint32
+	for _, utxoSrc := range utxoSrcsOwned {
+		err = utxoLockDBMgr.DBDelete(utxoSrc)
+		if err != nil {
+			return count, err
+		}
+		count += 1
+	}
+	return count, nil
+}
+
+// listLocked removes the expired locks and the locks of spent utxos
+func listLocked() ([]UtxoLockPrintAble, error) {
+	utxoLockMutex.Lock()
+	defer utxoLockMutex.Unlock()
+	now := time.Now().Unix()
+	var staleUtxoSrcs []UtxoSource
+	utxoLocks := []UtxoLockPrintAble{}
+	err := utxoLockDBMgr.DBIterate(func(k UtxoSource, v UtxoLock) error {
+		_, ok := getUnspentUtxo(k)
+		if v.isExpired(now) || !ok {
+			staleUtxoSrcs = append(staleUtxoSrcs, k)
+			return nil
+		}
+		utxoLocks = append(utxoLocks, GetUtxoLockPrintAble(&k, &v))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, utxoSrc := range staleUtxoSrcs {
+		err = utxoLockDBMgr.DBDelete(utxoSrc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return utxoLocks, nil
+}
+
+func isUtxoLocked(utxoSrc UtxoSource, now int64) bool {
+	utxoLock, err := utxoLockDBMgr.DBGet(utxoSrc)
+	if err != nil {
+		return false
+	}
+	return !utxoLock.isExpired(now)
+}
+
+func filterLockedUtxos(utxos []UtxoPrintAble) []UtxoPrintAble {
+	now := time.Now().Unix()
+	unlockedUtxos := make([]UtxoPrintAble, 0, len(utxos))
+	for _, utxo := range utxos {
+		if isUtxoLocked(utxo.GetUtxoSource(), now) {
+			continue
+		}
+		unlockedUtxos = append(unlockedUtxos, utxo)
+	}
+	return unlockedUtxos
+}
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"github.com/mutalisk999/bitcoin-lib/src/script"
+	"github.com/mutalisk999/bitcoin-lib/src/serialize"
+	"io"
+	"sync"
+)
+
+const (
+	UtxoSetInfoScriptTypeCount = script.TX_WITNESS_UNKNOWN + 1
+)
+
+const (
+	// the statistics stored by older versions with the sum hash is rebuilt
+	UtxoSetInfoKeyLegacy = "utxoSetInfo"
+	UtxoSetInfoKey       = "utxoSetInfoV2"
+	// outputs with larger scripts are unspendable like OP_RETURN
+	MaxScriptSize = 10000
+)
+
+// UtxoSetInfo keeps the statistics of utxo_db like gettxoutsetinfo of bitcoin core,
+// maintained by every flush of the slot cache, the unspendable outputs are excluded.
+// MuHash is the muhash of gettxoutsetinfo, it is unknown if the coinbase flag of any utxo
+// could not be resolved, the db is indexed before the trx locations are stored.
+type UtxoSetInfo struct {
+	UtxoCount        uint64
+	TotalAmount      int64
+	ScriptTypeCount  [UtxoSetInfoScriptTypeCount]uint64
+	ScriptTypeAmount [UtxoSetInfoScriptTypeCount]int64
+	IsMuHashKnown    bool
+	MuHash           MuHash3072
+	Mutex            *sync.Mutex
+}
+
+func newUtxoSetInfo() *UtxoSetInfo {
+	utxoSetInfoNew := new(UtxoSetInfo)
+	utxoSetInfoNew.IsMuHashKnown = true
+	utxoSetInfoNew.MuHash = newMuHash3072()
+	utxoSetInfoNew.Mutex = new(sync.Mutex)
+	return utxoSetInfoNew
+}
+
+func (u UtxoSetInfo) Pack(writer io.Writer) error {
+	err := serialize.PackUint64(writer, u.UtxoCount)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackInt64(writer, u.TotalAmount)
+	if err != nil {
+		return err
+	}
+	err = serialize.PackCompactSize(writer, uint64(UtxoSetInfoScriptTypeCount))
+	if err != nil {
+		return err
+	}
+	for i := 0; i < UtxoSetInfoScriptTypeCount; i++ {
+		err = serialize.PackUint64(writer, u.ScriptTypeCount[i])
+		if err != nil {
+			return err
+		}
+		err = serialize.PackInt64(writer, u.ScriptTypeAmount[i])
+		if err != nil {
+			return err
+		}
+	}
+	var isMuHashKnown byte = 0
+	if u.IsMuHashKnown {
+		isMuHashKnown = 1
+	}
+	_, err = writer.Write([]byte{isMuHashKnown})
+	if err != nil {
+		return err
+	}
+	err = u.MuHash.Pack(writer)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *UtxoSetInfo) UnPack(reader io.Reader) error {
+	var err error
+	u.UtxoCount, err = serialize.UnPackUint64(reader)
+	if err != nil {
+		return err
+	}
+	u.TotalAmount, err = serialize.UnPackInt64(reader)
+	if err != nil {
+		return err
+	}
+	ui64, err := serialize.UnPackCompactSize(reader)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < int(ui64); i++ {
+		count, err := serialize.UnPackUint64(reader)
+		if err != nil {
+			return err
+		}
+		amount, err := serialize.UnPackInt64(reader)
+		if err != nil {
+			return err
+		}
+		if i < UtxoSetInfoScriptTypeCount {
+			u.ScriptTypeCount[i] = count
+			u.ScriptTypeAmount[i] = amount
+		}
+	}
+	isMuHashKnown := make([]byte, 1)
+	_, err = io.ReadFull(reader, isMuHashKnown)
+	if err != nil {
+		return err
+	}
+	u.IsMuHashKnown = isMuHashKnown[0] != 0
+	err = u.MuHash.UnPack(reader)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// isUnspendableScript is IsUnspendable of bitcoin core, such outputs never enter its utxo set
+func isUnspendableScript(scriptPubKey script.Script) bool {
+	scriptBytes := scriptPubKey.GetScriptBytes()
+	return (len(scriptBytes) > 0 && scriptBytes[0] == 0x6a) || len(scriptBytes) > MaxScriptSize
+}
+
+// isCoinBaseUtxo resolves the coinbase flag by the trx location in the slot cache or trx_loc_db
+func isCoinBaseUtxo(utxoSrc UtxoSource, slotCache *SlotCache) (bool, error) {
+	if slotCache != nil {
+		trxLocation, ok := slotCache.TrxLocsAdd[string(utxoSrc.TrxId.GetData())]
+		if ok {
+			return trxLocation.BlockIndex == 0, nil
+		}
+	}
+	trxLocation, err := trxLocDBMgr.DBGet(utxoSrc.TrxId)
+	if err != nil {
+		return false, err
+	}
+	return trxLocation.BlockIndex == 0, nil
+}
+
+// serializeMuHashUtxo is TxOutSer of bitcoin core: outpoint | height << 1 | coinbase | txout
+func serializeMuHashUtxo(utxoSrc UtxoSource, utxoDetail UtxoDetail, isCoinBase bool) ([]byte, error) {
+	bytesBuf := bytes.NewBuffer([]byte{})
+	bufWriter := io.Writer(bytesBuf)
+	err := utxoSrc.Pack(bufWriter)
+	if err != nil {
+		return nil, err
+	}
+	heightCode := utxoDetail.BlockHeight << 1
+	if isCoinBase {
+		heightCode |= 1
+	}
+	err = serialize.PackUint32(bufWriter, heightCode)
+	if err != nil {
+		return nil, err
+	}
+	err = serialize.PackInt64(bufWriter, utxoDetail.Amount)
+	if err != nil {
+		return nil, err
+	}
+	err = utxoDetail.ScriptPubKey.Pack(bufWriter)
+	if err != nil {
+		return nil, err
+	}
+	return bytesBuf.Bytes(), nil
+}
+
+func (u *UtxoSetInfo) update(utxoSrc UtxoSource, utxoDetail UtxoDetail, isAdd bool, slotCache *SlotCache) error {
+	if isUnspendableScript(utxoDetail.ScriptPubKey) {
+		return nil
+	}
+	_, scriptType, _ := script.Solver(utxoDetail.ScriptPubKey)
+	if scriptType < 0 || scriptType >= UtxoSetInfoScriptTypeCount {
+		scriptType = script.TX_NONSTANDARD
+	}
+	if isAdd {
+		u.UtxoCount += 1
+		u.TotalAmount += utxoDetail.Amount
+		u.ScriptTypeCount[scriptType] += 1
+		u.ScriptTypeAmount[scriptType] += utxoDetail.Amount
+	} else {
+		u.UtxoCount -= 1
+		u.TotalAmount -= utxoDetail.Amount
+		u.ScriptTypeCount[scriptType] -= 1
+		u.ScriptTypeAmount[scriptType] -= utxoDetail.Amount
+	}
+	if !u.IsMuHashKnown {
+		return nil
+	}
+	isCoinBase, err := isCoinBaseUtxo(utxoSrc, slotCache)
+	if err != nil {
+		if err.Error() != NotFoundError {
+			return err
+		}
+		u.IsMuHashKnown = false
+		return nil
+	}
+	data, err := serializeMuHashUtxo(utxoSrc, utxoDetail, isCoinBase)
+	if err != nil {
+		return err
+	}
+	if isAdd {
+		return u.MuHash.Insert(data)
+	}
+	return u.MuHash.Remove(data)
+}
+
+func (u *UtxoSetInfo) Clone() UtxoSetInfo {
+	u.Mutex.Lock()
+	utxoSetInfoNew := *u
+	utxoSetInfoNew.MuHash = u.MuHash.Clone()
+	u.Mutex.Unlock()
+	utxoSetInfoNew.Mutex = new(sync.Mutex)
+	return utxoSetInfoNew
+}
+
+func (u *UtxoSetInfo) Assign(utxoSetInfoNew *UtxoSetInfo) {
+	u.Mutex.Lock()
+	u.UtxoCount = utxoSetInfoNew.UtxoCount
+	u.TotalAmount = utxoSetInfoNew.TotalAmount
+	u.ScriptTypeCount = utxoSetInfoNew.ScriptTypeCount
+	u.ScriptTypeAmount = utxoSetInfoNew.ScriptTypeAmount
+	u.IsMuHashKnown = utxoSetInfoNew.IsMuHashKnown
+	u.MuHash = utxoSetInfoNew.MuHash
+	u.Mutex.Unlock()
+}
+
+type UtxoScriptTypeInfoPrintAble struct {
+	ScriptType  string
+	UtxoCount   uint64
+	TotalAmount int64
+}
+
+type UtxoSetInfoPrintAble struct {
+	BlockHeight uint32
+	UtxoCount   uint64
+	TotalAmount int64
+	MuHash      string
+	ScriptTypes []UtxoScriptTypeInfoPrintAble
+}
+
+func (u *UtxoSetInfo) GetUtxoSetInfoPrintAble() UtxoSetInfoPrintAble {
+	var utxoSetInfoPrintAble UtxoSetInfoPrintAble
+	u.Mutex.Lock()
+	utxoSetInfoPrintAble.UtxoCount = u.UtxoCount
+	utxoSetInfoPrintAble.TotalAmount = u.TotalAmount
+	if u.IsMuHashKnown {
+		// displayed reversed like uint256 of bitcoin core
+		muHash := u.MuHash.Finalize()
+		for i := 0; i < len(muHash)/2; i++ {
+			muHash[i], muHash[len(muHash)-1-i] = muHash[len(muHash)-1-i], muHash[i]
+		}
+		utxoSetInfoPrintAble.MuHash = hex.EncodeToString(muHash[0:])
+	}
+	utxoSetInfoPrintAble.ScriptTypes = []UtxoScriptTypeInfoPrintAble{}
+	for i := 0; i < UtxoSetInfoScriptTypeCount; i++ {
+		if u.ScriptTypeCount[i] == 0 {
+			continue
+		}
+		var scriptTypeInfo UtxoScriptTypeInfoPrintAble
+		scriptTypeInfo.ScriptType = script.GetScriptTypeStr(i)
+		scriptTypeInfo.UtxoCount = u.ScriptTypeCount[i]
+		scriptTypeInfo.TotalAmount = u.ScriptTypeAmount[i]
+		utxoSetInfoPrintAble.ScriptTypes = append(utxoSetInfoPrintAble.ScriptTypes, scriptTypeInfo)
+	}
+	u.Mutex.Unlock()
+	return utxoSetInfoPrintAble
+}
+
+func storeUtxoSetInfo(utxoSetInfo *UtxoSetInfo) error {
+	bytesBuf := bytes.NewBuffer([]byte{})
+	err := utxoSetInfo.Pack(io.Writer(bytesBuf))
+	if err != nil {
+		return err
+	}
+	err = globalConfigDBMgr.DBPut(UtxoSetInfoKey, string(bytesBuf.Bytes()))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// applySlotCacheToUtxoSetInfo must be called before the utxos of the slot cache are stored
+func applySlotCacheToUtxoSetInfo(slotCache *SlotCache) error {
+	utxoSetInfoNew := utxoSetInfo.Clone()
+	for utxoSrcStr, utxoDetail := range slotCache.UtxosAdd {
+		var utxoSrc UtxoSource
+		err := utxoSrc.FromStreamString(utxoSrcStr)
+		if err != nil {
+			return err
+		}
+		// the duplicate coinbase trxs before bip30 overwrite the unspent outputs
+		utxoDetailOld, err := utxoDBMgr.DBGet(utxoSrc)
+		if err == nil {
+			err = utxoSetInfoNew.update(utxoSrc, utxoDetailOld, false, slotCache)
+			if err != nil {
+				return err
+			}
+		} else if err.Error() != NotFoundError {
+			return err
+		}
+		err = utxoSetInfoNew.update(utxoSrc, utxoDetail, true, slotCache)
+		if err != nil {
+			return err
+		}
+	}
+	for utxoSrcStr, utxoDetail := range slotCache.UtxosDel {
+		var utxoSrc UtxoSource
+		err := utxoSrc.FromStreamString(utxoSrcStr)
+		if err != nil {
+			return err
+		}
+		err = utxoSetInfoNew.update(utxoSrc, utxoDetail, false, slotCache)
+		if err != nil {
+			return err
+		}
+	}
+	err := storeUtxoSetInfo(&utxoSetInfoNew)
+	if err != nil {
+		return err
+	}
+	utxoSetInfo.Assign(&utxoSetInfoNew)
+	return nil
+}
+
+func calcUtxoSetInfo() (*UtxoSetInfo, error) {
+	utxoSetInfoNew := newUtxoSetInfo()
+	err := utxoDBMgr.DBIterate(func(utxoSrc UtxoSource, utxoDetail UtxoDetail) error {
+		return utxoSetInfoNew.update(utxoSrc, utxoDetail, true, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !utxoSetInfoNew.IsMuHashKnown {
+		fmt.Println("utxo set info rebuilt without muhash, the trx locations are not indexed, reindex to get it")
+	}
+	return utxoSetInfoNew, nil
+}
+
+func rebuildUtxoSetInfo() (*UtxoSetInfo, error) {
+	utxoSetInfoNew, err := calcUtxoSetInfo()
+	if err != nil {
+		return nil, err
+	}
+	err = storeUtxoSetInfo(utxoSetInfoNew)
+	if err != nil {
+		return nil, err
+	}
+	err = globalConfigDBMgr.DBDelete(UtxoSetInfoKeyLegacy)
+	if err != nil {
+		return nil, err
+	}
+	return utxoSetInfoNew, nil
+}
+
+func loadUtxoSetInfo() (*UtxoSetInfo, error) {
+	utxoSetInfoStr, err := globalConfigDBMgr.DBGet(UtxoSetInfoKey)
+	if err != nil {
+		if err.Error() != NotFoundError {
+			return nil, err
+		}
+		// the statistics is missing, the db is created before utxo set info or muhash is maintained
+		blockHeight, err := getStartBlockHeight()
+		if err != nil {
+			return nil, err
+		}
+		if blockHeight != 0 {
+			fmt.Println("utxo set info not found, rebuild from utxo db")
+		}
+		if isDBReadOnly {
+			return calcUtxoSetInfo()
+		}
+		return rebuildUtxoSetInfo()
+	}
+	utxoSetInfoNew := newUtxoSetInfo()
+	err = utxoSetInfoNew.UnPack(io.Reader(bytes.NewBuffer([]byte(utxoSetInfoStr))))
+	if err != nil {
+		return nil, err
+	}
+	return utxoSetInfoNew, nil
+}
+
+var utxoSetInfo *UtxoSetInfo
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/mutalisk999/bitcoin-lib/src/bigint"
+	"github.com/mutalisk999/bitcoin-lib/src/block"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	VerifyProblemsMax = 1000
+)
+
+type VerifyArgs struct {
+	FromHeight uint32
+	ToHeight   uint32
+}
+
+type VerifyReport struct {
+	ChainIndexState string
+	BlockHeight     uint32
+	TrxSequence     uint32
+	TrxSeqCount     uint32
+	AddrEntryCount  uint64
+	UtxoCount       uint64
+	UtxoTotalAmount int64
+	ExpectIssuance  int64
+	BlocksVerified  uint32
+	ProblemCount    uint64
+	Problems        []string
+}
+
+func (v *VerifyReport) addProblem(problem string) {
+	v.ProblemCount += 1
+	if len(v.Problems) < VerifyProblemsMax {
+		v.Problems = append(v.Problems, problem)
+	}
+}
+
+func calcBlockSubsidy(blockHeight uint32) int64 {
+	halvings := blockHeight / 210000
+	if halvings >= 64 {
+		return 0
+	}
+	var subsidy int64 = 50 * 100000000
+	return subsidy >> halvings
+}
+
+func verifyTrxSeqs(report *VerifyReport) error {
+	trxSeqsFound := make([]uint64, report.TrxSequence/64+1)
+	err := trxSeqDBMgr.DBIterate(func(trxSeq uint32, trxId bigint.Uint256) error {
+		if trxSeq == 0 || trxSeq > report.TrxSequence {
+			report.addProblem("trx seq " + strconv.Itoa(int(trxSeq)) + " is beyond trx sequence")
+			return nil
+		}
+		trxSeqsFound[trxSeq/64] |= 1 << (trxSeq % 64)
+		report.TrxSeqCount += 1
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for trxSeq := uint32(1); trxSeq <= report.TrxSequence; trxSeq++ {
+		if trxSeqsFound[trxSeq/64]&(1<<(trxSeq%64)) == 0 {
+			report.addProblem("trx seq " + strconv.Itoa(int(trxSeq)) + " maps to no trx id")
+		}
+	}
+	return nil
+}
+
+func verifyAddrTrxs(report *VerifyReport) error {
+	return addrTrxsDBMgr.DBIterate(func(key string, trxSeqs []uint32) error {
+		report.AddrEntryCount += 1
+		pos := strings.LastIndex(key, ".")
+		if pos < 0 {
+			report.addProblem("address entry " + key + " has no height suffix")
+			return nil
+		}
+		ui64, err := strconv.ParseUint(key[pos+1:], 10, 32)
+		if err != nil || uint32(ui64) > report.BlockHeight {
+			report.addProblem("address entry " + key + " has invalid height suffix")
+		}
+		for _, trxSeq := range trxSeqs {
+			if trxSeq == 0 || trxSeq > report.TrxSequence {
+				report.addProblem("address entry " + key + " points at invalid trx seq " + strconv.Itoa(int(trxSeq)))
+			}
+		}
+		return nil
+	})
+}
+
+func verifyUtxos(report *VerifyReport) error {
+	// the utxo set info excludes the unspendable outputs
+	var spendableCount uint64 = 0
+	var spendableAmount int64 = 0
+	err := utxoDBMgr.DBIterate(func(utxoSrc UtxoSource, utxoDetail UtxoDetail) error {
+		report.UtxoCount += 1
+		report.UtxoTotalAmount += utxoDetail.Amount
+		if !isUnspendableScript(utxoDetail.ScriptPubKey) {
+			spendableCount += 1
+			spendableAmount += utxoDetail.Amount
+		}
+		if utxoDetail.Amount < 0 {
+			report.addProblem("utxo " + utxoSrc.TrxId.GetHex() + ":" + strconv.Itoa(int(utxoSrc.Vout)) + " has negative amount")
+		}
+		if utxoDetail.BlockHeight > report.BlockHeight {
+			report.addProblem("utxo " + utxoSrc.TrxId.GetHex() + ":" + strconv.Itoa(int(utxoSrc.Vout)) + " is created beyond block height")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// the genesis block is never indexed
+	for blockHeight := uint32(1); blockHeight <= report.BlockHeight; blockHeight++ {
+		report.ExpectIssuance += calcBlockSubsidy(blockHeight)
+	}
+	if report.UtxoTotalAmount > report.ExpectIssuance {
+		report.addProblem("utxo total amount " + strconv.FormatInt(report.UtxoTotalAmount, 10) + " exceeds expected issuance " + strconv.FormatInt(report.ExpectIssuance, 10))
+	}
+	utxoSetInfoPrintAble := utxoSetInfo.GetUtxoSetInfoPrintAble()
+	if utxoSetInfoPrintAble.UtxoCount != spendableCount || utxoSetInfoPrintAble.TotalAmount != spendableAmount {
+		report.addProblem("utxo set info does not match utxo db")
+	}
+	return nil
+}
+
+func fetchVerifyBlock(blockHeight uint32) (*block.Block, error) {
+	rawBlockData, err := getRawBlockByHeight(blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	blockBytes, err := hex.DecodeString(rawBlockData)
+	if err != nil {
+		return nil, err
+	}
+	blockNew := new(block.Block)
+	err = blockNew.UnPack(io.Reader(bytes.NewBuffer(blockBytes)))
+	if err != nil {
+		return nil, err
+	}
+	return blockNew, nil
+}
+
+func verifyBlock(report *VerifyReport, blockHeight uint32, blockNew *block.Block) error {
+	blockHash, err := calcBlockHash(&blockNew.Header)
+	if err != nil {
+		return err
+	}
+
+	prefix := "block " + strconv.Itoa(int(blockHeight)) + ": "
+	blockInfo, err := blockDBMgr.DBGet(blockHeight)
+	isBlockInfoFound := err == nil
+	if !isBlockInfoFound {
+		report.addProblem(prefix + "block info not found")
+	} else {
+		if !bigint.IsUint256Equal(&blockInfo.BlockHash, &blockHash) {
+			report.addProblem(prefix + "block hash mismatch")
+		}
+		if blockInfo.TrxCount != uint32(len(blockNew.Vtx)) {
+			report.addProblem(prefix + "trx count mismatch")
+		}
+	}
+
+	for i := 0; i < len(blockNew.Vtx); i++ {
+		trx := &blockNew.Vtx[i]
+		trxId, err := trx.CalcTrxId()
+		if err != nil {
+			return err
+		}
+		trxPrefix := prefix + "trx " + trxId.GetHex() + ": "
+		trxLocation, err := trxLocDBMgr.DBGet(trxId)
+		if err != nil {
+			report.addProblem(trxPrefix + "trx location not found")
+			continue
+		}
+		if trxLocation.BlockHeight != blockHeight || trxLocation.BlockIndex != uint32(i) {
+			report.addProblem(trxPrefix + "trx location mismatch")
+		}
+		if isBlockInfoFound && trxLocation.TrxSeq != blockInfo.FirstTrxSeq+uint32(i) {
+			report.addProblem(trxPrefix + "trx seq is not contiguous in block")
+		}
+		trxIdBySeq, err := trxSeqDBMgr.DBGet(trxLocation.TrxSeq)
+		if err != nil || !bigint.IsUint256Equal(&trxIdBySeq, &trxId) {
+			report.addProblem(trxPrefix + "trx seq does not map to trx id")
+		}
+
+		// outputs
+		for index, vout := range trx.Vout {
+			addrStr := getAddressFromScript(vout.ScriptPubKey)
+			if addrStr != "" {
+				trxSeqs, err := addrTrxsDBMgr.DBGetPrefix(addrStr + ".")
+				if err != nil {
+					return err
+				}
+				isFound := false
+				for _, trxSeq := range trxSeqs {
+					if trxSeq == trxLocation.TrxSeq {
+						isFound = true
+						break
+					}
+				}
+				if !isFound {
+					report.addProblem(trxPrefix + "address " + addrStr + " does not point at trx")
+				}
+			}
+			utxoDetail, err := utxoDBMgr.DBGet(UtxoSource{trxId, uint32(index)})
+			if err == nil {
+				if utxoDetail.Amount != vout.Value || utxoDetail.BlockHeight != blockHeight ||
+					!bytes.Equal(utxoDetail.ScriptPubKey.GetScriptBytes(), vout.ScriptPubKey.GetScriptBytes()) {
+					report.addProblem(trxPrefix + "utxo " + strconv.Itoa(index) + " mismatch")
+				}
+			}
+		}
+
+		// inputs
+		if i == 0 || !config.GatherConfig.StoreTrxUndo {
+			continue
+		}
+		spentUtxos, err := trxUndoDBMgr.DBGet(trxId)
+		if err != nil {
+			report.addProblem(trxPrefix + "trx undo not found")
+			continue
+		}
+		if len(spentUtxos) != len(trx.Vin) {
+			report.addProblem(trxPrefix + "trx undo count mismatch")
+			continue
+		}
+		for index, vin := range trx.Vin {
+			if !bigint.IsUint256Equal(&spentUtxos[index].UtxoSource.TrxId, &vin.PrevOut.Hash) || spentUtxos[index].UtxoSource.Vout != vin.PrevOut.N {
+				report.addProblem(trxPrefix + "trx undo " + strconv.Itoa(index) + " mismatch")
+			}
+		}
+	}
+	report.BlocksVerified += 1
+	return nil
+}
+
+// verifyIndexDBs checks the dbs with each other, the flush mutex is held so that they stay at the same height
+func verifyIndexDBs(report *VerifyReport) error {
+	flushMutex.Lock()
+	defer flushMutex.Unlock()
+
+	var err error
+	report.ChainIndexState, err = getChainIndexState()
+	if err != nil && err.Error() != NotFoundError {
+		return err
+	}
+	report.BlockHeight, err = getStartBlockHeight()
+	if err != nil {
+		return err
+	}
+	report.TrxSequence, err = getStartTrxSequence()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("verify trx seq db")
+	err = verifyTrxSeqs(report)
+	if err != nil {
+		return err
+	}
+	fmt.Println("verify addr trx db")
+	err = verifyAddrTrxs(report)
+	if err != nil {
+		return err
+	}
+	fmt.Println("verify utxo db")
+	err = verifyUtxos(report)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// verifyIndexBlock fetches the block without the flush mutex, then holds it only to diff with the index
+func verifyIndexBlock(report *VerifyReport, blockHeight uint32) error {
+	blockNew, err := fetchVerifyBlock(blockHeight)
+	if err != nil {
+		return err
+	}
+	flushMutex.Lock()
+	defer flushMutex.Unlock()
+	flushedHeight, err := getStartBlockHeight()
+	if err != nil {
+		return err
+	}
+	if blockHeight > flushedHeight {
+		return errors.New("block " + strconv.Itoa(int(blockHeight)) + " is removed by reindex while verifying")
+	}
+	return verifyBlock(report, blockHeight, blockNew)
+}
+
+func verifyIndexDB(args *VerifyArgs) (VerifyReport, error) {
+	var report VerifyReport
+	report.Problems = []string{}
+	err := verifyIndexDBs(&report)
+	if err != nil {
+		return VerifyReport{}, err
+	}
+
+	// re-derive the block range from the data source and diff with the index
+	if args != nil && args.FromHeight != 0 {
+		if args.ToHeight < args.FromHeight || args.ToHeight > report.BlockHeight {
+			return VerifyReport{}, errors.New("invalid block range")
+		}
+		fmt.Println("verify block range", args.FromHeight, "-", args.ToHeight)
+		for blockHeight := args.FromHeight; blockHeight <= args.ToHeight; blockHeight++ {
+			if quitFlag {
+				break
+			}
+			err = verifyIndexBlock(&report, blockHeight)
+			if err != nil {
+				return VerifyReport{}, err
+			}
+		}
+	}
+	return report, nil
+}
+
+func parseVerifyArgs(args []string) (*VerifyArgs, error) {
+	verifyArgs := new(VerifyArgs)
+	if len(args) == 0 {
+		return verifyArgs, nil
+	}
+	if len(args) != 2 {
+		return nil, errors.New("usage: verify [fromHeight toHeight]")
+	}
+	fromHeight, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	toHeight, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	verifyArgs.FromHeight = uint32(fromHeight)
+	verifyArgs.ToHeight = uint32(toHeight)
+	return verifyArgs, nil
+}
+
+func printVerifyReport(report *VerifyReport) {
+	fmt.Println("ChainIndexState:", report.ChainIndexState)
+	fmt.Println("BlockHeight:", report.BlockHeight)
+	fmt.Println("TrxSequence:", report.TrxSequence)
+	fmt.Println("TrxSeqCount:", report.TrxSeqCount)
+	fmt.Println("AddrEntryCount:", report.AddrEntryCount)
+	fmt.Println("UtxoCount:", report.UtxoCount)
+	fmt.Println("UtxoTotalAmount:", report.UtxoTotalAmount)
+	fmt.Println("ExpectIssuance:", report.ExpectIssuance)
+	fmt.Println("BlocksVerified:", report.BlocksVerified)
+	fmt.Println("ProblemCount:", report.ProblemCount)
+	for _, problem := range report.Problems {
+		fmt.Println("  ", problem)
+	}
+}
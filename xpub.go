@@ -0,0 +1,233 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"github.com/mutalisk999/bitcoin-lib/src/keyid"
+	"github.com/mutalisk999/bitcoin-lib/src/utility"
+	"strconv"
+)
+
+const (
+	XpubGapLimitDefault   = 20
+	XpubGapLimitMax       = 100
+	XpubChainAddressMax   = 2000
+	XpubScriptTypeP2PKH   = "p2pkh"
+	XpubScriptTypeP2SHWPK = "p2sh-p2wpkh"
+	XpubScriptTypeP2WPKH  = "p2wpkh"
+	XpubScriptTypeP2TR    = "p2tr"
+)
+
+type XpubScanArgs struct {
+	Xpub       string
+	ScriptType string
+	GapLimit   uint32
+}
+
+type XpubAddressPrintAble struct {
+	Path      string
+	Chain     uint32
+	Index     uint32
+	Address   string
+	TrxCount  uint32
+	Balance   int64
+	UtxoCount uint32
+}
+
+type XpubScanPrintAble struct {
+	ScriptType         string
+	GapLimit           uint32
+	Balance            int64
+	UtxoCount          uint32
+	NextReceiveIndex   uint32
+	NextReceiveAddress string
+	NextChangeIndex    uint32
+	NextChangeAddress  string
+	Addresses          []XpubAddressPrintAble
+	Trxs               []TrxStatusPrintAble
+	Utxos              []UtxoPrintAble
+}
+
+type xpubAddress struct {
+	chain    uint32
+	index    uint32
+	address  string
+	trxCount uint32
+}
+
+func getPubKeyAddress(pubKey []byte, scriptType string) (string, error) {
+	var keyId keyid.KeyID
+	err := keyId.SetKeyIDData(utility.Hash160(pubKey))
+	if err != nil {
+		return "", err
+	}
+	if scriptType == XpubScriptTypeP2PKH {
+		return keyId.ToBase58Address(networkParams.PubKeyHashVersion)
+	} else if scriptType == XpubScriptTypeP2SHWPK {
+		redeemScript := append([]byte{0x00, 0x14}, utility.Hash160(pubKey)...)
+		var scriptId keyid.KeyID
+		err = scriptId.SetKeyIDData(utility.Hash160(redeemScript))
+		if err != nil {
+			return "", err
+		}
+		return scriptId.ToBase58Address(networkParams.ScriptHashVersion)
+	} else if scriptType == XpubScriptTypeP2WPKH {
+		return encodeSegWitAddress(networkParams.SegWitHrp, 0, utility.Hash160(pubKey))
+	} else if scriptType == XpubScriptTypeP2TR {
+		outputKey, err := taprootOutputKey(pubKey)
+		if err != nil {
+			return "", err
+		}
+		return encodeSegWitAddress(networkParams.SegWitHrp, 1, outputKey)
+	}
+	return "", errors.New("invalid script type: " + scriptType)
+}
+
+func getXpubScriptType(extPubKey *ExtendedPubKey, scriptType string) (string, error) {
+	versionScriptType, ok := networkParams.XpubVersions[hex.EncodeToString(extPubKey.Version[0:])]
+	if !ok {
+		return "", errors.New("not support extended public key version")
+	}
+	if scriptType == "" {
+		return versionScriptType, nil
+	}
+	if scriptType != XpubScriptTypeP2PKH && scriptType != XpubScriptTypeP2SHWPK &&
+		scriptType != XpubScriptTypeP2WPKH && scriptType != XpubScriptTypeP2TR {
+		return "", errors.New("invalid script type: " + scriptType)
+	}
+	// ypub and zpub imply the script type
+	if versionScriptType != XpubScriptTypeP2PKH && versionScriptType != scriptType {
+		return "", errors.New("script type mismatch with extended public key version")
+	}
+	return scriptType, nil
+}
+
+// scanXpubChain walks the chain until gapLimit consecutive addresses have no trx
+func scanXpubChain(chainKey *ExtendedPubKey, chain uint32, scriptType string, gapLimit uint32) ([]xpubAddress, uint32, error) {
+	var usedAddresses []xpubAddress
+	var nextIndex uint32
+	var gap uint32
+	var index uint32
+	for gap < gapLimit {
+		if index >= XpubChainAddressMax {
+			return nil, 0, errors.New("too many addresses in chain " + strconv.Itoa(int(chain)))
+		}
+		// derive a batch of addresses and look them up concurrently
+		var batch []xpubAddress
+		for uint32(len(batch)) < gapLimit-gap && index < XpubChainAddressMax {
+			childKey, err := chainKey.Child(index)
+			if err == nil {
+				addrStr, err := getPubKeyAddress(childKey.PubKey[0:], scriptType)
+				if err != nil {
+					return nil, 0, err
+				}
+				batch = append(batch, xpubAddress{chain, index, addrStr, 0})
+			}
+			index += 1
+		}
+		err := queryConcurrently(len(batch), func(i int) error {
+			trxSeqs, err := getAddressTrxSeqs(batch[i].address)
+			if err != nil {
+				return err
+			}
+			batch[i].trxCount = uint32(len(trxSeqs))
+			return nil
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, addr := range batch {
+			if addr.trxCount == 0 {
+				gap += 1
+				continue
+			}
+			gap = 0
+			nextIndex = addr.index + 1
+			usedAddresses = append(usedAddresses, addr)
+		}
+	}
+	return usedAddresses, nextIndex, nil
+}
+
+func scanXpub(args *XpubScanArgs) (XpubScanPrintAble, error) {
+	extPubKey, err := parseExtendedPubKey(args.Xpub)
+	if err != nil {
+		return XpubScanPrintAble{}, err
+	}
+	var xpubScan XpubScanPrintAble
+	xpubScan.ScriptType, err = getXpubScriptType(extPubKey, args.ScriptType)
+	if err != nil {
+		return XpubScanPrintAble{}, err
+	}
+	xpubScan.GapLimit = args.GapLimit
+	if xpubScan.GapLimit == 0 {
+		xpubScan.GapLimit = XpubGapLimitDefault
+	}
+	if xpubScan.GapLimit > XpubGapLimitMax {
+		return XpubScanPrintAble{}, errors.New("gap limit is too large, max: " + strconv.Itoa(XpubGapLimitMax))
+	}
+
+	// external chain 0 and internal chain 1
+	var usedAddresses []xpubAddress
+	for chain := uint32(0); chain <= 1; chain++ {
+		chainKey, err := extPubKey.Child(chain)
+		if err != nil {
+			return XpubScanPrintAble{}, err
+		}
+		chainUsedAddresses, nextIndex, err := scanXpubChain(chainKey, chain, xpubScan.ScriptType, xpubScan.GapLimit)
+		if err != nil {
+			return XpubScanPrintAble{}, err
+		}
+		usedAddresses = append(usedAddresses, chainUsedAddresses...)
+		nextAddress := ""
+		for ; nextIndex < XpubChainAddressMax; nextIndex++ {
+			childKey, err := chainKey.Child(nextIndex)
+			if err == nil {
+				nextAddress, err = getPubKeyAddress(childKey.PubKey[0:], xpubScan.ScriptType)
+				if err != nil {
+					return XpubScanPrintAble{}, err
+				}
+				break
+			}
+		}
+		if chain == 0 {
+			xpubScan.NextReceiveIndex, xpubScan.NextReceiveAddress = nextIndex, nextAddress
+		} else {
+			xpubScan.NextChangeIndex, xpubScan.NextChangeAddress = nextIndex, nextAddress
+		}
+	}
+
+	addresses := make([]string, 0, len(usedAddresses))
+	for _, addr := range usedAddresses {
+		addresses = append(addresses, addr.address)
+	}
+	addressesTrxs, err := getAddressesTrxs(addresses)
+	if err != nil {
+		return XpubScanPrintAble{}, err
+	}
+	addressesUtxos, err := listUnSpentMulti(addresses)
+	if err != nil {
+		return XpubScanPrintAble{}, err
+	}
+
+	xpubScan.Addresses = []XpubAddressPrintAble{}
+	xpubScan.Utxos = []UtxoPrintAble{}
+	xpubScan.Trxs = addressesTrxs.Trxs
+	for i, addr := range usedAddresses {
+		var xpubAddress XpubAddressPrintAble
+		xpubAddress.Path = "m/" + strconv.Itoa(int(addr.chain)) + "/" + strconv.Itoa(int(addr.index))
+		xpubAddress.Chain = addr.chain
+		xpubAddress.Index = addr.index
+		xpubAddress.Address = addr.address
+		xpubAddress.TrxCount = uint32(len(addressesTrxs.Addresses[i].TrxIds))
+		for _, utxo := range addressesUtxos[i].Utxos {
+			xpubAddress.Balance += utxo.Amount
+			xpubAddress.UtxoCount += 1
+		}
+		xpubScan.Balance += xpubAddress.Balance
+		xpubScan.UtxoCount += xpubAddress.UtxoCount
+		xpubScan.Utxos = append(xpubScan.Utxos, addressesUtxos[i].Utxos...)
+		xpubScan.Addresses = append(xpubScan.Addresses, xpubAddress)
+	}
+	return xpubScan, nil
+}